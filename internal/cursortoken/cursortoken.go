@@ -0,0 +1,160 @@
+// Package cursortoken implements the signed cursor tokens behind
+// EncodeCursor and DecodeCursor of each dialect, which do not depend on the
+// SQL dialect.
+package cursortoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrInvalid is returned by Decode if the token is malformed or has been
+// tampered with.
+var ErrInvalid = errors.New("invalid cursor")
+
+// cursorValue is the JSON representation of a single cursor value. The type
+// is recorded alongside the value so that it survives the round trip.
+type cursorValue struct {
+	Type  string          `json:"t"`
+	Value json.RawMessage `json:"v,omitempty"`
+}
+
+// Encode encodes the cursor values into an opaque token, signed with the key
+// using HMAC-SHA256 so that any tampering is detected. Supported values are
+// nil, bools, integers, floats, strings, []byte, time.Time and any
+// driver.Valuer that returns one of those.
+func Encode(key []byte, values ...interface{}) (string, error) {
+	if len(key) == 0 {
+		return "", errors.New("cursor key cannot be empty")
+	}
+	cursorValues := make([]cursorValue, len(values))
+	for i, value := range values {
+		var err error
+		if valuer, ok := value.(driver.Valuer); ok {
+			value, err = valuer.Value()
+			if err != nil {
+				return "", err
+			}
+		}
+		var typ string
+		switch v := value.(type) {
+		case nil:
+			typ = "null"
+		case bool:
+			typ = "bool"
+		case int, int8, int16, int32, int64:
+			typ = "int"
+		case uint, uint8, uint16, uint32, uint64:
+			typ = "uint"
+		case float32, float64:
+			typ = "float"
+		case string:
+			typ = "string"
+		case []byte:
+			typ = "bytes"
+		case time.Time:
+			typ = "time"
+		default:
+			return "", fmt.Errorf("unsupported cursor value type %T", v)
+		}
+		cursorValues[i].Type = typ
+		if value != nil {
+			cursorValues[i].Value, err = json.Marshal(value)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+	payload, err := json.Marshal(cursorValues)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// Decode decodes a token created by Encode back into its cursor values.
+// Integers are decoded as int64 (or uint64), floats as float64 and times as
+// time.Time. It returns ErrInvalid if the token was not signed with the same
+// key.
+func Decode(key []byte, token string) ([]interface{}, error) {
+	if len(key) == 0 {
+		return nil, errors.New("cursor key cannot be empty")
+	}
+	i := strings.IndexByte(token, '.')
+	if i < 0 {
+		return nil, ErrInvalid
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(token[:i])
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(token[i+1:])
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, ErrInvalid
+	}
+	var cursorValues []cursorValue
+	err = json.Unmarshal(payload, &cursorValues)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	values := make([]interface{}, len(cursorValues))
+	for i, cv := range cursorValues {
+		var dest interface{}
+		switch cv.Type {
+		case "null":
+			continue
+		case "bool":
+			dest = new(bool)
+		case "int":
+			dest = new(int64)
+		case "uint":
+			dest = new(uint64)
+		case "float":
+			dest = new(float64)
+		case "string":
+			dest = new(string)
+		case "bytes":
+			dest = new([]byte)
+		case "time":
+			dest = new(time.Time)
+		default:
+			return nil, ErrInvalid
+		}
+		err = json.Unmarshal(cv.Value, dest)
+		if err != nil {
+			return nil, ErrInvalid
+		}
+		switch v := dest.(type) {
+		case *bool:
+			values[i] = *v
+		case *int64:
+			values[i] = *v
+		case *uint64:
+			values[i] = *v
+		case *float64:
+			values[i] = *v
+		case *string:
+			values[i] = *v
+		case *[]byte:
+			values[i] = *v
+		case *time.Time:
+			values[i] = *v
+		}
+	}
+	return values, nil
+}
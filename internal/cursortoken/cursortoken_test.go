@@ -0,0 +1,60 @@
+package cursortoken
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	is := is.New(t)
+	key := []byte("secret")
+	now := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	// Round trip
+	token, err := Encode(key, nil, true, 5, uint8(6), 1.5, "bob", []byte("bytes"), now, sql.NullInt64{Int64: 7, Valid: true})
+	is.NoErr(err)
+	values, err := Decode(key, token)
+	is.NoErr(err)
+	is.Equal([]interface{}{nil, true, int64(5), uint64(6), 1.5, "bob", []byte("bytes"), now, int64(7)}, values)
+
+	// Empty cursor
+	token, err = Encode(key)
+	is.NoErr(err)
+	values, err = Decode(key, token)
+	is.NoErr(err)
+	is.Equal(0, len(values))
+
+	// Unsupported type
+	_, err = Encode(key, struct{}{})
+	is.True(err != nil)
+
+	// Empty key
+	_, err = Encode(nil, 1)
+	is.True(err != nil)
+	_, err = Decode(nil, token)
+	is.True(err != nil)
+
+	// Wrong key
+	token, err = Encode(key, 1)
+	is.NoErr(err)
+	_, err = Decode([]byte("wrong"), token)
+	is.True(errors.Is(err, ErrInvalid))
+
+	// Tampered payload
+	i := strings.IndexByte(token, '.')
+	tampered, err := Encode([]byte("wrong"), 2)
+	is.NoErr(err)
+	_, err = Decode(key, tampered[:strings.IndexByte(tampered, '.')]+token[i:])
+	is.True(errors.Is(err, ErrInvalid))
+
+	// Malformed tokens
+	for _, token := range []string{"", "abc", "abc.def", "!!!." + token[i+1:], token[:i] + ".!!!"} {
+		_, err = Decode(key, token)
+		is.True(errors.Is(err, ErrInvalid))
+	}
+}
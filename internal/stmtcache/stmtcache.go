@@ -0,0 +1,206 @@
+// Package stmtcache implements the prepared statement cache behind the
+// StmtCache of each dialect, which does not depend on the SQL dialect.
+package stmtcache
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"sync"
+)
+
+// Preparer is a database handle that can prepare statements, e.g. *sql.DB,
+// *sql.Tx or *sql.Conn.
+type Preparer interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// Cache prepares every query on first use and reuses the prepared statement
+// whenever the same query is run again. Statements are keyed by their SQL
+// text. Once the cache is full the least recently used statement is closed to
+// make room for a new one. A Cache is safe for concurrent use.
+type Cache struct {
+	db      Preparer
+	maxSize int
+	mu      sync.Mutex
+	lru     *list.List // of *cacheEntry, most recently used first
+	stmts   map[string]*list.Element
+}
+
+// cacheEntry is a prepared statement in a Cache. inUse counts the queries
+// that have been handed the statement but have not finished running it yet:
+// an evicted statement is only closed once inUse drops to zero.
+type cacheEntry struct {
+	query   string
+	stmt    *sql.Stmt
+	inUse   int
+	evicted bool
+}
+
+// New creates a new Cache that holds up to maxSize prepared statements. If
+// maxSize is zero or less, the cache is unbounded.
+func New(db Preparer, maxSize int) *Cache {
+	return &Cache{
+		db:      db,
+		maxSize: maxSize,
+		lru:     list.New(),
+		stmts:   make(map[string]*list.Element),
+	}
+}
+
+// Query runs the query with a cached prepared statement.
+func (c *Cache) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return c.QueryContext(context.Background(), query, args...)
+}
+
+// QueryContext runs the query with a cached prepared statement.
+func (c *Cache) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	entry, err := c.prepare(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer c.release(entry)
+	rows, err := entry.stmt.QueryContext(ctx, args...)
+	if err != nil && isConnError(err) {
+		c.invalidate(entry)
+	}
+	return rows, err
+}
+
+// Exec executes the query with a cached prepared statement.
+func (c *Cache) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return c.ExecContext(context.Background(), query, args...)
+}
+
+// ExecContext executes the query with a cached prepared statement.
+func (c *Cache) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	entry, err := c.prepare(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer c.release(entry)
+	result, err := entry.stmt.ExecContext(ctx, args...)
+	if err != nil && isConnError(err) {
+		c.invalidate(entry)
+	}
+	return result, err
+}
+
+// Len returns the number of prepared statements in the Cache.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// Close closes every prepared statement in the Cache. Statements that are
+// still being run are closed as soon as they finish. The Cache can still be
+// used afterwards, it will simply prepare the statements again.
+func (c *Cache) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var err error
+	for e := c.lru.Front(); e != nil; e = e.Next() {
+		if e2 := c.evict(e.Value.(*cacheEntry)); e2 != nil && err == nil {
+			err = e2
+		}
+	}
+	c.lru.Init()
+	c.stmts = make(map[string]*list.Element)
+	return err
+}
+
+// prepare returns the cache entry for the query, preparing the statement if
+// it is not already in the cache. The entry is marked as in use until it is
+// passed to release.
+func (c *Cache) prepare(ctx context.Context, query string) (*cacheEntry, error) {
+	c.mu.Lock()
+	if e, ok := c.stmts[query]; ok {
+		c.lru.MoveToFront(e)
+		entry := e.Value.(*cacheEntry)
+		entry.inUse++
+		c.mu.Unlock()
+		return entry, nil
+	}
+	c.mu.Unlock()
+	// prepare without holding the lock so that a slow prepare does not block
+	// queries that are already cached
+	stmt, err := c.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.stmts[query]; ok {
+		// another goroutine prepared the same query in the meantime
+		_ = stmt.Close()
+		c.lru.MoveToFront(e)
+		entry := e.Value.(*cacheEntry)
+		entry.inUse++
+		return entry, nil
+	}
+	entry := &cacheEntry{query: query, stmt: stmt, inUse: 1}
+	c.stmts[query] = c.lru.PushFront(entry)
+	for c.maxSize > 0 && c.lru.Len() > c.maxSize {
+		e := c.lru.Back()
+		evicted := c.lru.Remove(e).(*cacheEntry)
+		delete(c.stmts, evicted.query)
+		_ = c.evict(evicted)
+	}
+	return entry, nil
+}
+
+// release marks the entry as no longer in use by a query, closing its
+// statement if it was evicted in the meantime. Rows that are still open keep
+// working after their statement is closed, database/sql only finalizes the
+// statement once the rows are closed.
+func (c *Cache) release(entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry.inUse--
+	if entry.evicted && entry.inUse == 0 {
+		_ = entry.stmt.Close()
+	}
+}
+
+// evict marks an entry that has been removed from the cache as evicted,
+// closing its statement right away if no query is using it. c.mu must be held.
+func (c *Cache) evict(entry *cacheEntry) error {
+	if entry.evicted {
+		return nil
+	}
+	entry.evicted = true
+	if entry.inUse > 0 {
+		return nil
+	}
+	return entry.stmt.Close()
+}
+
+// invalidate removes the entry from the cache, if it is still the one in the
+// cache for its query.
+func (c *Cache) invalidate(entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.stmts[entry.query]
+	if !ok || e.Value.(*cacheEntry) != entry {
+		return
+	}
+	c.lru.Remove(e)
+	delete(c.stmts, entry.query)
+	_ = c.evict(entry)
+}
+
+// isConnError reports whether the error means the connection to the database
+// was lost, in which case any statement prepared on it can no longer be used.
+func isConnError(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,188 @@
+package stmtcache
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+// fakeDriver is a database/sql driver whose statements do nothing, so that the
+// Cache can be tested without a database. It counts the statements that are
+// prepared and closed. Preparing "invalid" fails, and running "lost" fails as
+// if the connection was lost.
+type fakeDriver struct {
+	prepared atomic.Int64
+	closed   atomic.Int64
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) { return fakeConn{d}, nil }
+
+func (d *fakeDriver) Connect(ctx context.Context) (driver.Conn, error) { return fakeConn{d}, nil }
+
+func (d *fakeDriver) Driver() driver.Driver { return d }
+
+type fakeConn struct {
+	d *fakeDriver
+}
+
+func (conn fakeConn) Prepare(query string) (driver.Stmt, error) {
+	if query == "invalid" {
+		return nil, errors.New("syntax error")
+	}
+	conn.d.prepared.Add(1)
+	return fakeStmt{d: conn.d, query: query}, nil
+}
+
+func (conn fakeConn) Close() error { return nil }
+
+func (conn fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("fakeConn does not support transactions")
+}
+
+type fakeStmt struct {
+	d     *fakeDriver
+	query string
+}
+
+func (stmt fakeStmt) Close() error {
+	stmt.d.closed.Add(1)
+	return nil
+}
+
+func (stmt fakeStmt) NumInput() int { return -1 }
+
+func (stmt fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if stmt.query == "lost" {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (stmt fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if stmt.query == "lost" {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return fakeRows{}, nil
+}
+
+type fakeRows struct{}
+
+func (rows fakeRows) Columns() []string { return nil }
+
+func (rows fakeRows) Close() error { return nil }
+
+func (rows fakeRows) Next(dest []driver.Value) error { return io.EOF }
+
+func TestIsConnError(t *testing.T) {
+	is := is.New(t)
+	is.True(isConnError(driver.ErrBadConn))
+	is.True(isConnError(fmt.Errorf("wrapped: %w", sql.ErrConnDone)))
+	is.True(isConnError(io.ErrUnexpectedEOF))
+	is.True(!isConnError(sql.ErrNoRows))
+}
+
+func TestCache(t *testing.T) {
+	is := is.New(t)
+	d := &fakeDriver{}
+	db := sql.OpenDB(d)
+	defer db.Close()
+	cache := New(db, 2)
+
+	// Statements are reused
+	for i := 0; i < 3; i++ {
+		_, err := cache.Exec("a")
+		is.NoErr(err)
+	}
+	is.Equal(int64(1), d.prepared.Load())
+	is.Equal(1, cache.Len())
+	rows, err := cache.Query("b")
+	is.NoErr(err)
+	is.NoErr(rows.Close())
+	is.Equal(2, cache.Len())
+
+	// The least recently used statement is evicted
+	_, err = cache.Exec("b")
+	is.NoErr(err)
+	_, err = cache.Exec("c")
+	is.NoErr(err)
+	is.Equal(2, cache.Len())
+	_, ok := cache.stmts["a"]
+	is.True(!ok)
+	is.Equal(int64(1), d.closed.Load())
+
+	// Errors are returned as is
+	_, err = cache.Exec("invalid")
+	is.True(err != nil)
+	is.Equal(2, cache.Len())
+
+	// A statement whose connection was lost is removed
+	_, err = cache.Exec("lost")
+	is.True(errors.Is(err, io.ErrUnexpectedEOF))
+	_, ok = cache.stmts["lost"]
+	is.True(!ok)
+	is.Equal(1, cache.Len())
+
+	// Close
+	is.NoErr(cache.Close())
+	is.Equal(0, cache.Len())
+	is.Equal(d.prepared.Load(), d.closed.Load())
+	_, err = cache.Exec("a")
+	is.NoErr(err)
+	is.Equal(1, cache.Len())
+	is.NoErr(cache.Close())
+
+	// Unbounded
+	cache = New(db, 0)
+	defer cache.Close()
+	for i := 0; i < 10; i++ {
+		_, err = cache.Exec(strconv.Itoa(i))
+		is.NoErr(err)
+	}
+	is.Equal(10, cache.Len())
+}
+
+func TestCache_ConcurrentEviction(t *testing.T) {
+	is := is.New(t)
+	db := sql.OpenDB(&fakeDriver{})
+	defer db.Close()
+	// a cache of one statement evicts on nearly every query, so a statement is
+	// often evicted between being handed out and being run
+	cache := New(db, 1)
+	defer cache.Close()
+	var wg sync.WaitGroup
+	errs := make(chan error, 16*500)
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				query := "SELECT " + strconv.Itoa((i+j)%4)
+				if j%2 == 0 {
+					_, err := cache.ExecContext(context.Background(), query)
+					errs <- err
+					continue
+				}
+				rows, err := cache.QueryContext(context.Background(), query)
+				if err == nil {
+					err = rows.Close()
+				}
+				errs <- err
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		is.NoErr(err)
+	}
+	is.Equal(1, cache.Len())
+}
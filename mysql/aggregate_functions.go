@@ -88,3 +88,66 @@ func MaxOver(field interface{}, window Window) NumberField {
 		values: []interface{}{field, window},
 	}
 }
+
+// CountDistinct represents the COUNT(DISTINCT) aggregate function, which
+// counts the distinct non-NULL values of the field.
+func CountDistinct(field interface{}) NumberField {
+	format := "COUNT(DISTINCT ?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{field},
+	}
+}
+
+// Distinct marks the argument of an aggregate function so that only its
+// distinct values are aggregated e.g. Sum(Distinct(field)) is 'SUM(DISTINCT
+// field)'.
+func Distinct(field interface{}) CustomField {
+	return CustomField{
+		Format: "DISTINCT ?",
+		Values: []interface{}{field},
+	}
+}
+
+// GroupConcat represents the GROUP_CONCAT() aggregate function, which
+// concatenates the values separated by the separator. The values are
+// concatenated in the order of the orderBy fields if any i.e.
+// 'GROUP_CONCAT(field ORDER BY orderBy SEPARATOR separator)'. MySQL only
+// accepts a string literal as the separator, so it is written into the query
+// instead of being passed as an argument. Its backslashes are escaped for the
+// default sql_mode, so avoid backslashes in the separator if the server runs
+// with NO_BACKSLASH_ESCAPES.
+func GroupConcat(field interface{}, separator string, orderBy ...Field) StringField {
+	format := "GROUP_CONCAT(?"
+	values := []interface{}{field}
+	if len(orderBy) > 0 {
+		format += " ORDER BY ?"
+		values = append(values, Fields(orderBy))
+	}
+	format += " SEPARATOR " + quoteString(separator) + ")"
+	return StringField{
+		format: &format,
+		values: values,
+	}
+}
+
+// JSONArrayAgg represents the JSON_ARRAYAGG() aggregate function, which
+// collects the values into a JSON array. MySQL does not support ordering the
+// values inside JSON_ARRAYAGG.
+func JSONArrayAgg(field interface{}) JSONField {
+	format := "JSON_ARRAYAGG(?)"
+	return JSONField{
+		format: &format,
+		values: []interface{}{field},
+	}
+}
+
+// JSONObjectAgg represents the JSON_OBJECTAGG() aggregate function, which
+// collects the key value pairs into a JSON object.
+func JSONObjectAgg(key, value interface{}) JSONField {
+	format := "JSON_OBJECTAGG(?, ?)"
+	return JSONField{
+		format: &format,
+		values: []interface{}{key, value},
+	}
+}
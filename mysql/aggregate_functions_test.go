@@ -87,6 +87,48 @@ func TestAggregateFunctions(t *testing.T) {
 			"MAX(ur.user_role_id) OVER (PARTITION BY ur.user_id)",
 			nil,
 		},
+		{
+			"CountDistinct",
+			CountDistinct(ur.USER_ID),
+			nil,
+			"COUNT(DISTINCT ur.user_id)",
+			nil,
+		},
+		{
+			"Sum Distinct",
+			Sum(Distinct(ur.USER_ID)),
+			nil,
+			"SUM(DISTINCT ur.user_id)",
+			nil,
+		},
+		{
+			"GroupConcat",
+			GroupConcat(ur.ROLE, ","),
+			nil,
+			"GROUP_CONCAT(ur.role SEPARATOR ',')",
+			nil,
+		},
+		{
+			"GroupConcat ordered",
+			GroupConcat(Distinct(ur.ROLE), "'; ", ur.ROLE.Desc(), ur.USER_ID),
+			[]string{"ur"},
+			"GROUP_CONCAT(DISTINCT role ORDER BY role DESC, user_id SEPARATOR '''; ')",
+			nil,
+		},
+		{
+			"JSONArrayAgg",
+			JSONArrayAgg(ur.ROLE),
+			nil,
+			"JSON_ARRAYAGG(ur.role)",
+			nil,
+		},
+		{
+			"JSONObjectAgg",
+			JSONObjectAgg(ur.ROLE, ur.USER_ID),
+			nil,
+			"JSON_OBJECTAGG(ur.role, ur.user_id)",
+			nil,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -3,6 +3,7 @@ package sq
 import (
 	"log"
 	"os"
+	"time"
 )
 
 // LogFlag is a flag that affects the verbosity of the Logger output.
@@ -24,8 +25,49 @@ type ExecFlag int
 const (
 	ElastInsertID ExecFlag = 1 << iota
 	ErowsAffected
+	// Etransaction makes ExecBatched run all of its statements inside one
+	// transaction.
+	Etransaction
 )
 
+// LogAction indicates which action was performed on a query when a LogFunc is
+// invoked.
+type LogAction string
+
+// LogActions
+const (
+	LogActionToSQL LogAction = "ToSQL"
+	LogActionFetch LogAction = "Fetch"
+	LogActionExec  LogAction = "Exec"
+)
+
+// LogInfo contains the information about a query that is passed to a
+// LogFunc.
+type LogInfo struct {
+	LogFlag LogFlag
+	// LogSkip is the calldepth to pass to (*log.Logger).Output from within the
+	// LogFunc so that the log reports the caller of ToSQL/Fetch/Exec.
+	LogSkip   int
+	Query     string
+	Args      []interface{}
+	Action    LogAction
+	TimeTaken time.Duration
+	Err       error
+	// Fetch
+	RowsFetched int64
+	// Exec
+	ExecFlag     ExecFlag
+	RowsAffected int64
+	// LastInsertID is only populated for Exec with the ElastInsertID ExecFlag.
+	LastInsertID int64
+}
+
+// LogFunc is a function that is called with the LogInfo of a query after it
+// is marshalled with ToSQL, fetched with Fetch or executed with Exec. Unlike
+// Log, it receives each part of the log as a separate field so that it can be
+// fed into a structured logger.
+type LogFunc func(LogInfo)
+
 var defaultLogger = log.New(os.Stdout, "[sq] ", log.Ldate|log.Ltime|log.Lshortfile|log.Lmsgprefix)
 
 // BaseQuery is a common query builder that can transform into a SelectQuery,
@@ -35,6 +77,7 @@ type BaseQuery struct {
 	DB      DB
 	Log     Logger
 	LogFlag LogFlag
+	LogFunc LogFunc
 	CTEs    []CTE
 }
 
@@ -46,6 +89,13 @@ func WithDefaultLog(flag LogFlag) BaseQuery {
 	}
 }
 
+// WithLogFunc creates a new BaseQuery with the LogFunc.
+func WithLogFunc(fn LogFunc) BaseQuery {
+	return BaseQuery{
+		LogFunc: fn,
+	}
+}
+
 // WithDB creates a new BaseQuery with the DB.
 func WithDB(db DB) BaseQuery {
 	return BaseQuery{
@@ -67,6 +117,12 @@ func (q BaseQuery) WithDefaultLog(flag LogFlag) BaseQuery {
 	return q
 }
 
+// WithLogFunc adds the LogFunc to the BaseQuery.
+func (q BaseQuery) WithLogFunc(fn LogFunc) BaseQuery {
+	q.LogFunc = fn
+	return q
+}
+
 // WithDB adds the DB to the BaseQuery.
 func (q BaseQuery) WithDB(db DB) BaseQuery {
 	q.DB = db
@@ -87,9 +143,15 @@ func (q BaseQuery) From(table Table) SelectQuery {
 		DB:        q.DB,
 		Log:       q.Log,
 		LogFlag:   q.LogFlag,
+		LogFunc:   q.LogFunc,
 	}
 }
 
+// Fromx transforms the BaseQuery into a SelectQuery.
+func (q BaseQuery) Fromx(joined JoinedTablesGetter) SelectQuery {
+	return q.From(nil).Fromx(joined)
+}
+
 // Select transforms the BaseQuery into a SelectQuery.
 func (q BaseQuery) Select(fields ...Field) SelectQuery {
 	return SelectQuery{
@@ -98,6 +160,7 @@ func (q BaseQuery) Select(fields ...Field) SelectQuery {
 		DB:           q.DB,
 		Log:          q.Log,
 		LogFlag:      q.LogFlag,
+		LogFunc:      q.LogFunc,
 	}
 }
 
@@ -109,6 +172,7 @@ func (q BaseQuery) SelectOne() SelectQuery {
 		DB:           q.DB,
 		Log:          q.Log,
 		LogFlag:      q.LogFlag,
+		LogFunc:      q.LogFunc,
 	}
 }
 
@@ -120,6 +184,7 @@ func (q BaseQuery) SelectAll() SelectQuery {
 		DB:           q.DB,
 		Log:          q.Log,
 		LogFlag:      q.LogFlag,
+		LogFunc:      q.LogFunc,
 	}
 }
 
@@ -131,6 +196,7 @@ func (q BaseQuery) SelectCount() SelectQuery {
 		DB:           q.DB,
 		Log:          q.Log,
 		LogFlag:      q.LogFlag,
+		LogFunc:      q.LogFunc,
 	}
 }
 
@@ -143,6 +209,7 @@ func (q BaseQuery) SelectDistinct(fields ...Field) SelectQuery {
 		DB:           q.DB,
 		Log:          q.Log,
 		LogFlag:      q.LogFlag,
+		LogFunc:      q.LogFunc,
 	}
 }
 
@@ -155,6 +222,7 @@ func (q BaseQuery) Selectx(mapper func(*Row), accumulator func()) SelectQuery {
 		DB:          q.DB,
 		Log:         q.Log,
 		LogFlag:     q.LogFlag,
+		LogFunc:     q.LogFunc,
 	}
 }
 
@@ -166,6 +234,7 @@ func (q BaseQuery) SelectRowx(mapper func(*Row)) SelectQuery {
 		DB:        q.DB,
 		Log:       q.Log,
 		LogFlag:   q.LogFlag,
+		LogFunc:   q.LogFunc,
 	}
 }
 
@@ -176,6 +245,7 @@ func (q BaseQuery) InsertInto(table BaseTable) InsertQuery {
 		DB:        q.DB,
 		Log:       q.Log,
 		LogFlag:   q.LogFlag,
+		LogFunc:   q.LogFunc,
 	}
 }
 
@@ -187,6 +257,7 @@ func (q BaseQuery) InsertIgnoreInto(table BaseTable) InsertQuery {
 		DB:        q.DB,
 		Log:       q.Log,
 		LogFlag:   q.LogFlag,
+		LogFunc:   q.LogFunc,
 	}
 }
 
@@ -198,9 +269,15 @@ func (q BaseQuery) Update(table BaseTable) UpdateQuery {
 		DB:          q.DB,
 		Log:         q.Log,
 		LogFlag:     q.LogFlag,
+		LogFunc:     q.LogFunc,
 	}
 }
 
+// Updatex transforms the BaseQuery into an UpdateQuery.
+func (q BaseQuery) Updatex(joined JoinedTablesGetter) UpdateQuery {
+	return q.Update(nil).Updatex(joined)
+}
+
 // DeleteFrom transforms the BaseQuery into a DeleteQuery.
 func (q BaseQuery) DeleteFrom(tables ...BaseTable) DeleteQuery {
 	return DeleteQuery{
@@ -209,6 +286,7 @@ func (q BaseQuery) DeleteFrom(tables ...BaseTable) DeleteQuery {
 		DB:         q.DB,
 		Log:        q.Log,
 		LogFlag:    q.LogFlag,
+		LogFunc:    q.LogFunc,
 	}
 }
 
@@ -221,6 +299,7 @@ func (q BaseQuery) Union(queries ...Query) VariadicQuery {
 		DB:       q.DB,
 		Log:      q.Log,
 		LogFlag:  q.LogFlag,
+		LogFunc:  q.LogFunc,
 	}
 }
 
@@ -233,5 +312,6 @@ func (q BaseQuery) UnionAll(queries ...Query) VariadicQuery {
 		DB:       q.DB,
 		Log:      q.Log,
 		LogFlag:  q.LogFlag,
+		LogFunc:  q.LogFunc,
 	}
 }
@@ -132,6 +132,13 @@ func (f BooleanField) Desc() BooleanField {
 	return f
 }
 
+// ordering implements the orderedField interface.
+func (f BooleanField) ordering() (field Field, descending *bool) {
+	descending = f.descending
+	f.descending = nil
+	return f, descending
+}
+
 // IsNull returns an 'X IS NULL' Predicate.
 func (f BooleanField) IsNull() Predicate {
 	return CustomPredicate{
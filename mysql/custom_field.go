@@ -54,6 +54,13 @@ func (f CustomField) Desc() CustomField {
 	return f
 }
 
+// ordering implements the orderedField interface.
+func (f CustomField) ordering() (field Field, descending *bool) {
+	descending = f.IsDesc
+	f.IsDesc = nil
+	return f, descending
+}
+
 // IsNull returns an 'X IS NULL' Predicate.
 func (f CustomField) IsNull() Predicate {
 	return CustomPredicate{
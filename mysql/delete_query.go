@@ -33,6 +33,7 @@ type DeleteQuery struct {
 	// Logging
 	Log     Logger
 	LogFlag LogFlag
+	LogFunc LogFunc
 	logSkip int
 }
 
@@ -42,6 +43,15 @@ func (q DeleteQuery) ToSQL() (string, []interface{}) {
 	buf := &strings.Builder{}
 	var args []interface{}
 	q.AppendSQL(buf, &args, nil)
+	if q.LogFunc != nil {
+		q.LogFunc(LogInfo{
+			LogFlag: q.LogFlag,
+			LogSkip: q.logSkip + 2,
+			Query:   buf.String(),
+			Args:    args,
+			Action:  LogActionToSQL,
+		})
+	}
 	return buf.String(), args
 }
 
@@ -168,6 +178,15 @@ func (q DeleteQuery) Using(table Table) DeleteQuery {
 	return q
 }
 
+// Usingx sets the USING table of the DeleteQuery to the JoinedTables' FROM
+// table, followed by its JoinTables.
+func (q DeleteQuery) Usingx(joined JoinedTablesGetter) DeleteQuery {
+	jt := joined.GetJoinedTables()
+	q.UsingTable = jt.FromTable
+	q.JoinTables = jt.prependJoins(q.JoinTables)
+	return q
+}
+
 // Join joins a new table to the DeleteQuery based on the predicates.
 func (q DeleteQuery) Join(table Table, predicate Predicate, predicates ...Predicate) DeleteQuery {
 	predicates = append([]Predicate{predicate}, predicates...)
@@ -262,14 +281,31 @@ func (q DeleteQuery) Exec(db DB, flag ExecFlag) (rowsAffected int64, err error)
 // ExecContext will execute the DeleteQuery with the given DB and context. It will
 // only compute the rowsAffected if the ErowsAffected Execflag is passed to it.
 func (q DeleteQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (rowsAffected int64, err error) {
+	logBuf := &strings.Builder{}
+	start := time.Now()
+	var logQuery string
+	var logArgs []interface{}
+	if q.LogFunc != nil {
+		defer func() {
+			q.LogFunc(LogInfo{
+				LogFlag:      q.LogFlag,
+				LogSkip:      q.logSkip + 3,
+				Query:        logQuery,
+				Args:         logArgs,
+				Action:       LogActionExec,
+				TimeTaken:    time.Since(start),
+				Err:          err,
+				ExecFlag:     flag,
+				RowsAffected: rowsAffected,
+			})
+		}()
+	}
 	if db == nil {
 		if q.DB == nil {
 			return rowsAffected, errors.New("DB cannot be nil")
 		}
 		db = q.DB
 	}
-	logBuf := &strings.Builder{}
-	start := time.Now()
 	defer func() {
 		if q.Log == nil {
 			return
@@ -296,6 +332,9 @@ func (q DeleteQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (row
 	var tmpargs []interface{}
 	q.logSkip += 1
 	q.AppendSQL(tmpbuf, &tmpargs, nil)
+	if q.LogFunc != nil {
+		logQuery, logArgs = tmpbuf.String(), tmpargs
+	}
 	if ctx == nil {
 		res, err = db.Exec(tmpbuf.String(), tmpargs...)
 	} else {
@@ -0,0 +1,77 @@
+package sq
+
+import (
+	"context"
+	"fmt"
+)
+
+// FetchSlice will run the query with the given DB and return the results of
+// calling the mapper on each row. The query can be a SelectQuery or a
+// VariadicQuery. Any mapper and accumulator already set on the query are
+// ignored.
+func FetchSlice[T any](db DB, q Query, mapper func(*Row) T) ([]T, error) {
+	return fetchSlice(nil, db, q, mapper)
+}
+
+// FetchSliceContext will run the query with the given DB and context and
+// return the results of calling the mapper on each row.
+func FetchSliceContext[T any](ctx context.Context, db DB, q Query, mapper func(*Row) T) ([]T, error) {
+	return fetchSlice(ctx, db, q, mapper)
+}
+
+// FetchOne will run the query with the given DB and return the result of
+// calling the mapper on the first row. If the query returns no rows,
+// sql.ErrNoRows is returned.
+func FetchOne[T any](db DB, q Query, mapper func(*Row) T) (T, error) {
+	return fetchOne(nil, db, q, mapper)
+}
+
+// FetchOneContext will run the query with the given DB and context and return
+// the result of calling the mapper on the first row. If the query returns no
+// rows, sql.ErrNoRows is returned.
+func FetchOneContext[T any](ctx context.Context, db DB, q Query, mapper func(*Row) T) (T, error) {
+	return fetchOne(ctx, db, q, mapper)
+}
+
+// Get scans the field into a value of type T. T can be any type that can be
+// passed (as a pointer) to ScanInto.
+func Get[T any](row *Row, field Field) T {
+	var value T
+	row.ScanInto(&value, field)
+	return value
+}
+
+func fetchSlice[T any](ctx context.Context, db DB, q Query, mapper func(*Row) T) (items []T, err error) {
+	var item T
+	err = fetch(ctx, db, q, func(row *Row) {
+		item = mapper(row)
+	}, func() {
+		items = append(items, item)
+	})
+	return items, err
+}
+
+func fetchOne[T any](ctx context.Context, db DB, q Query, mapper func(*Row) T) (item T, err error) {
+	err = fetch(ctx, db, q, func(row *Row) {
+		item = mapper(row)
+	}, nil)
+	return item, err
+}
+
+// fetch sets the mapper and accumulator on the query and fetches it. There
+// are always three stack frames between the caller of FetchSlice/FetchOne and
+// FetchContext (compared to one for Fetch), so logSkip is incremented by 3.
+func fetch(ctx context.Context, db DB, q Query, mapper func(*Row), accumulator func()) error {
+	switch q := q.(type) {
+	case SelectQuery:
+		q.RowMapper, q.Accumulator = mapper, accumulator
+		q.logSkip += 3
+		return q.FetchContext(ctx, db)
+	case VariadicQuery:
+		q.Mapper, q.Accumulator = mapper, accumulator
+		q.logSkip += 3
+		return q.FetchContext(ctx, db)
+	default:
+		return fmt.Errorf("cannot fetch from %T", q)
+	}
+}
@@ -0,0 +1,67 @@
+package sq
+
+import "strings"
+
+// GenerateSeriesTable is a Table created by GenerateSeries. It has a single
+// DATETIME column, which is named by calling the Column method.
+type GenerateSeriesTable struct {
+	alias  string
+	start  interface{}
+	stop   interface{}
+	step   Interval
+	column string
+}
+
+// GenerateSeries creates a new table with one row for every timestamp from
+// start to stop inclusive, stepping by the Interval. The start and stop can be
+// a time.Time or a TimeField. MySQL has no generate_series, so it is rendered
+// as a derived table over a recursive CTE, which means it is limited to
+// @@cte_max_recursion_depth rows (1000 by default). MySQL requires every
+// derived table to have an alias.
+//
+//	days := GenerateSeries("days", start, end, Interval{Days: 1})
+//	day := days.Column("day")
+//	From(days).LeftJoin(o, o.CREATED_AT.DateTrunc("day").Eq(day)).GroupBy(day).Select(day, Count())
+func GenerateSeries(alias string, start, stop interface{}, step Interval) *GenerateSeriesTable {
+	return &GenerateSeriesTable{
+		alias: alias,
+		start: start,
+		stop:  stop,
+		step:  step,
+	}
+}
+
+// AppendSQL marshals the GenerateSeriesTable into a buffer and an args slice.
+func (tbl *GenerateSeriesTable) AppendSQL(buf *strings.Builder, args *[]interface{}, params map[string]int) {
+	column := tbl.column
+	if column == "" {
+		column = tbl.alias
+	}
+	if strings.ContainsAny(column, " \t") {
+		column = "`" + column + "`"
+	}
+	next := column + intervalFormat("+", tbl.step)
+	buf.WriteString("(WITH RECURSIVE series (" + column + ") AS (SELECT CAST(")
+	appendSQLValue(buf, args, nil, tbl.start)
+	buf.WriteString(" AS DATETIME(6)) UNION ALL SELECT " + next + " FROM series WHERE " + next + " <= ")
+	appendSQLValue(buf, args, nil, tbl.stop)
+	buf.WriteString(") SELECT " + column + " FROM series)")
+}
+
+// GetAlias implements the Table interface. It returns the alias of the
+// GenerateSeriesTable.
+func (tbl *GenerateSeriesTable) GetAlias() string {
+	return tbl.alias
+}
+
+// GetName implements the Table interface. It always returns an empty string,
+// as a GenerateSeriesTable can only be referred to by its alias.
+func (tbl *GenerateSeriesTable) GetName() string {
+	return ""
+}
+
+// Column names the timestamp column and returns it as a TimeField.
+func (tbl *GenerateSeriesTable) Column(name string) TimeField {
+	tbl.column = name
+	return NewTimeField(name, tbl)
+}
@@ -0,0 +1,51 @@
+package sq
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestGenerateSeries(t *testing.T) {
+	is := is.New(t)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)
+	a := APPLICATIONS().As("a")
+	days := GenerateSeries("days", start, end, Interval{Days: 1})
+	day := days.Column("day")
+	gotQuery, gotArgs := From(days).
+		LeftJoin(a, a.CREATED_AT.DateTrunc("day").Eq(day)).
+		GroupBy(day).
+		OrderBy(day).
+		Select(day, Count()).
+		ToSQL()
+	is.Equal("SELECT days.day, COUNT(*)"+
+		" FROM (WITH RECURSIVE series (day) AS (SELECT CAST(? AS DATETIME(6))"+
+		" UNION ALL SELECT day + INTERVAL 1 DAY FROM series WHERE day + INTERVAL 1 DAY <= ?)"+
+		" SELECT day FROM series) AS days"+
+		" LEFT JOIN devlab.applications AS a ON CAST(DATE(a.created_at) AS DATETIME) = days.day"+
+		" GROUP BY days.day"+
+		" ORDER BY days.day", gotQuery)
+	is.Equal([]interface{}{start, end}, gotArgs)
+
+	if testing.Short() {
+		return
+	}
+	db, err := sql.Open("txdb", "GenerateSeries")
+	is.NoErr(err)
+	defer db.Close()
+	var got []time.Time
+	series := GenerateSeries("series", start, end, Interval{Duration: 12 * time.Hour})
+	ts := series.Column("ts")
+	err = From(series).
+		OrderBy(ts).
+		Selectx(func(row *Row) {
+			got = append(got, row.Time(ts))
+		}, nil).
+		Fetch(db)
+	is.NoErr(err)
+	is.Equal(5, len(got))
+	is.True(got[4].Equal(end))
+}
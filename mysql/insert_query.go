@@ -31,6 +31,7 @@ type InsertQuery struct {
 	// Logging
 	Log     Logger
 	LogFlag LogFlag
+	LogFunc LogFunc
 	logSkip int
 }
 
@@ -44,6 +45,15 @@ func (q InsertQuery) ToSQL() (query string, args []interface{}) {
 	q.logSkip += 1
 	buf := &strings.Builder{}
 	q.AppendSQL(buf, &args, nil)
+	if q.LogFunc != nil {
+		q.LogFunc(LogInfo{
+			LogFlag: q.LogFlag,
+			LogSkip: q.logSkip + 2,
+			Query:   buf.String(),
+			Args:    args,
+			Action:  LogActionToSQL,
+		})
+	}
 	return buf.String(), args
 }
 
@@ -205,14 +215,32 @@ func (q InsertQuery) Exec(db DB, flag ExecFlag) (lastInsertID, rowsAffected int6
 // is passed to it. To compute both, bitwise or the flags together i.e.
 // ElastInsertID|ErowsAffected.
 func (q InsertQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (lastInsertID, rowsAffected int64, err error) {
+	logBuf := &strings.Builder{}
+	start := time.Now()
+	var logQuery string
+	var logArgs []interface{}
+	if q.LogFunc != nil {
+		defer func() {
+			q.LogFunc(LogInfo{
+				LogFlag:      q.LogFlag,
+				LogSkip:      q.logSkip + 3,
+				Query:        logQuery,
+				Args:         logArgs,
+				Action:       LogActionExec,
+				TimeTaken:    time.Since(start),
+				Err:          err,
+				ExecFlag:     flag,
+				RowsAffected: rowsAffected,
+				LastInsertID: lastInsertID,
+			})
+		}()
+	}
 	if db == nil {
 		if q.DB == nil {
 			return lastInsertID, rowsAffected, errors.New("DB cannot be nil")
 		}
 		db = q.DB
 	}
-	logBuf := &strings.Builder{}
-	start := time.Now()
 	defer func() {
 		if r := recover(); r != nil {
 			switch v := r.(type) {
@@ -248,6 +276,9 @@ func (q InsertQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (las
 	var tmpargs []interface{}
 	q.logSkip += 1
 	q.AppendSQL(tmpbuf, &tmpargs, nil)
+	if q.LogFunc != nil {
+		logQuery, logArgs = tmpbuf.String(), tmpargs
+	}
 	if ctx == nil {
 		res, err = db.Exec(tmpbuf.String(), tmpargs...)
 	} else {
@@ -271,6 +302,96 @@ func (q InsertQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (las
 	return lastInsertID, rowsAffected, nil
 }
 
+// batches splits the InsertQuery into InsertQueries of at most batchSize rows
+// each. An InsertQuery without VALUES (e.g. INSERT ... SELECT) is not split.
+func (q InsertQuery) batches(batchSize int) (batches []InsertQuery, err error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("batchSize must be positive, got %d", batchSize)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			switch v := r.(type) {
+			case error:
+				err = v
+			default:
+				err = fmt.Errorf("%#v", r)
+			}
+		}
+	}()
+	if q.ColumnMapper != nil {
+		col := &Column{mode: colmodeInsert}
+		q.ColumnMapper(col)
+		q.InsertColumns = col.insertColumns
+		q.RowValues = col.rowValues
+		q.ColumnMapper = nil
+	}
+	if len(q.RowValues) <= batchSize {
+		return []InsertQuery{q}, nil
+	}
+	rowValues := q.RowValues
+	for len(rowValues) > 0 {
+		n := batchSize
+		if n > len(rowValues) {
+			n = len(rowValues)
+		}
+		q.RowValues = rowValues[:n:n]
+		batches = append(batches, q)
+		rowValues = rowValues[n:]
+	}
+	return batches, nil
+}
+
+// ExecBatched is like Exec, but splits the rows of the InsertQuery into
+// several INSERT statements of at most batchSize rows each, which keeps large
+// inserts under the MySQL limit of 65535 bind parameters per statement and
+// under max_allowed_packet. The rowsAffected of every statement are added
+// together, and the lastInsertID is the ID generated for the first inserted
+// row (as with a single multi-row INSERT). If the Etransaction
+// ExecFlag is passed, all of the statements are run inside one transaction
+// (or a savepoint, if db is already a transaction).
+func (q InsertQuery) ExecBatched(db DB, batchSize int, flag ExecFlag) (lastInsertID, rowsAffected int64, err error) {
+	q.logSkip += 1
+	return q.ExecBatchedContext(nil, db, batchSize, flag)
+}
+
+// ExecBatchedContext is like ExecBatched, but runs every statement with the
+// given context.
+func (q InsertQuery) ExecBatchedContext(ctx context.Context, db DB, batchSize int, flag ExecFlag) (lastInsertID, rowsAffected int64, err error) {
+	if db == nil {
+		if q.DB == nil {
+			return lastInsertID, rowsAffected, errors.New("DB cannot be nil")
+		}
+		db = q.DB
+	}
+	batches, err := q.batches(batchSize)
+	if err != nil {
+		return lastInsertID, rowsAffected, err
+	}
+	logSkip := 2
+	execBatches := func(db DB) error {
+		lastInsertID, rowsAffected = 0, 0
+		for i, batch := range batches {
+			batch.logSkip += logSkip
+			id, n, err := batch.ExecContext(ctx, db, flag)
+			if i == 0 {
+				lastInsertID = id
+			}
+			rowsAffected += n
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if Etransaction&flag == 0 {
+		err = execBatches(db)
+		return lastInsertID, rowsAffected, err
+	}
+	logSkip += 2
+	err = Tx(ctx, db, nil, execBatches)
+	return lastInsertID, rowsAffected, err
+}
+
 // NestThis indicates to the InsertQuery that it is nested.
 func (q InsertQuery) NestThis() Query {
 	q.nested = true
@@ -293,3 +293,93 @@ func TestInsertQuery_Basic(t *testing.T) {
 	is.Equal(true, q.Ignore)
 	is.Equal(nil, q.IntoTable)
 }
+
+func TestInsertQuery_batches(t *testing.T) {
+	is := is.New(t)
+	u := USERS()
+	names := []string{"aaa", "bbb", "ccc", "ddd", "eee"}
+	q := InsertInto(u).Valuesx(func(col *Column) {
+		for _, name := range names {
+			col.SetString(u.DISPLAYNAME, name)
+			col.SetString(u.EMAIL, name+"@email.com")
+		}
+	})
+
+	batches, err := q.batches(2)
+	is.NoErr(err)
+	is.Equal(3, len(batches))
+	gotQuery, gotArgs := batches[0].ToSQL()
+	is.Equal("INSERT INTO devlab.users (displayname, email) VALUES (?, ?), (?, ?)", gotQuery)
+	is.Equal([]interface{}{"aaa", "aaa@email.com", "bbb", "bbb@email.com"}, gotArgs)
+	gotQuery, gotArgs = batches[2].ToSQL()
+	is.Equal("INSERT INTO devlab.users (displayname, email) VALUES (?, ?)", gotQuery)
+	is.Equal([]interface{}{"eee", "eee@email.com"}, gotArgs)
+
+	// Fewer rows than batchSize
+	batches, err = q.batches(10)
+	is.NoErr(err)
+	is.Equal(1, len(batches))
+
+	// INSERT ... SELECT is not split
+	batches, err = InsertInto(u).Columns(u.DISPLAYNAME).Select(Select(u.EMAIL).From(u)).batches(1)
+	is.NoErr(err)
+	is.Equal(1, len(batches))
+
+	// Invalid batchSize
+	_, err = q.batches(0)
+	is.True(err != nil)
+
+	// Panicking ColumnMapper
+	_, err = InsertInto(u).Valuesx(func(col *Column) { panic("oops") }).batches(1)
+	is.True(err != nil)
+}
+
+func TestInsertQuery_ExecBatched(t *testing.T) {
+	if testing.Short() {
+		return
+	}
+	is := is.New(t)
+	db, err := sql.Open("txdb", "InsertQuery_ExecBatched")
+	is.NoErr(err)
+	defer db.Close()
+	u := USERS()
+	countUsers := func() int {
+		var count int
+		err := WithDB(db).From(u).SelectRowx(func(row *Row) {
+			row.ScanInto(&count, Count())
+		}).Fetch(nil)
+		is.NoErr(err)
+		return count
+	}
+	insertUsers := func(names ...string) InsertQuery {
+		return WithDB(db).InsertInto(u).Valuesx(func(col *Column) {
+			for _, name := range names {
+				col.SetString(u.DISPLAYNAME, name)
+				col.SetString(u.EMAIL, name+"@batched.com")
+			}
+		})
+	}
+	count := countUsers()
+
+	// Missing DB
+	_, _, err = InsertInto(u).Valuesx(func(col *Column) {}).ExecBatched(nil, 1, 0)
+	is.True(err != nil)
+
+	// rowsAffected is aggregated
+	lastInsertID, rowsAffected, err := insertUsers("a1", "a2", "a3", "a4", "a5").ExecBatched(nil, 2, ElastInsertID|ErowsAffected)
+	is.NoErr(err)
+	is.Equal(int64(5), rowsAffected)
+	var firstUserID int64
+	err = WithDB(db).From(u).Where(u.EMAIL.EqString("a1@batched.com")).SelectRowx(func(row *Row) {
+		firstUserID = row.Int64(u.USER_ID)
+	}).Fetch(nil)
+	is.NoErr(err)
+	is.Equal(firstUserID, lastInsertID)
+	is.Equal(count+5, countUsers())
+
+	// Etransaction
+	_, rowsAffected, err = insertUsers("d1", "d2", "d3").ExecBatched(nil, 2, ErowsAffected|Etransaction)
+	is.NoErr(err)
+	is.Equal(int64(3), rowsAffected)
+	is.Equal(count+8, countUsers())
+}
@@ -121,3 +121,102 @@ func TestJoinTables_AppendSQL(t *testing.T) {
 		})
 	}
 }
+
+type JOIN_USERS_ALL struct {
+	JoinedTables
+	U   TABLE_USERS
+	UR  TABLE_USER_ROLES
+	URS TABLE_USER_ROLES_STUDENTS
+}
+
+func USERS_ALL() JOIN_USERS_ALL {
+	join := JOIN_USERS_ALL{}
+	join.U = USERS().As("u")
+	join.UR = USER_ROLES().As("ur")
+	join.URS = USER_ROLES_STUDENTS().As("urs")
+	join.JoinedTables = JoinedFrom(join.U).
+		Join(join.UR, join.UR.USER_ID.Eq(join.U.USER_ID)).
+		LeftJoin(join.URS, join.URS.USER_ROLE_ID.Eq(join.UR.USER_ROLE_ID))
+	return join
+}
+
+func TestJoinedTables(t *testing.T) {
+	type TT struct {
+		description string
+		q           Query
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	ua := USERS_ALL()
+	t1 := TEAMS().As("t1")
+	t2 := TEAMS().As("t2")
+	// joins appended onto a shared JoinedTables must not affect each other,
+	// even if its JoinTables has spare capacity
+	base := JoinedFrom(ua.U)
+	base.JoinTables = make(JoinTables, 0, 10)
+	base = base.Join(ua.UR, ua.UR.USER_ID.Eq(ua.U.USER_ID))
+	jt1 := base.CustomJoin("CROSS JOIN", t1)
+	jt2 := base.CustomJoin("CROSS JOIN", t2)
+	tests := []TT{
+		{
+			"Fromx",
+			Fromx(ua).Where(ua.URS.TEAM_ID.EqInt(15)).Select(ua.U.USER_ID, ua.URS.TEAM_ID),
+			"SELECT u.user_id, urs.team_id FROM devlab.users AS u JOIN devlab.user_roles AS ur ON ur.user_id = u.user_id LEFT JOIN devlab.user_roles_students AS urs ON urs.user_role_id = ur.user_role_id WHERE urs.team_id = ?",
+			[]interface{}{15},
+		},
+		{
+			"Fromx with further joins",
+			WithDB(nil).Fromx(ua).Join(t1, t1.TEAM_ID.Eq(ua.URS.TEAM_ID)).Select(t1.TEAM_ID),
+			"SELECT t1.team_id FROM devlab.users AS u JOIN devlab.user_roles AS ur ON ur.user_id = u.user_id LEFT JOIN devlab.user_roles_students AS urs ON urs.user_role_id = ur.user_role_id JOIN devlab.teams AS t1 ON t1.team_id = urs.team_id",
+			nil,
+		},
+		{
+			"shared JoinedTables 1",
+			Fromx(jt1).SelectOne(),
+			"SELECT 1 FROM devlab.users AS u JOIN devlab.user_roles AS ur ON ur.user_id = u.user_id CROSS JOIN devlab.teams AS t1",
+			nil,
+		},
+		{
+			"shared JoinedTables 2",
+			Fromx(jt2).SelectOne(),
+			"SELECT 1 FROM devlab.users AS u JOIN devlab.user_roles AS ur ON ur.user_id = u.user_id CROSS JOIN devlab.teams AS t2",
+			nil,
+		},
+		{
+			"Updatex",
+			Updatex(ua).Set(ua.U.DISPLAYNAME.SetString("bob")).Where(ua.URS.TEAM_ID.EqInt(15)),
+			"UPDATE devlab.users AS u JOIN devlab.user_roles AS ur ON ur.user_id = u.user_id LEFT JOIN devlab.user_roles_students AS urs ON urs.user_role_id = ur.user_role_id SET u.displayname = ? WHERE urs.team_id = ?",
+			[]interface{}{"bob", 15},
+		},
+		{
+			"Updatex with further joins",
+			WithDB(nil).Updatex(ua).Join(t1, t1.TEAM_ID.Eq(ua.URS.TEAM_ID)).Set(ua.U.DISPLAYNAME.Set(t1.TEAM_NAME)),
+			"UPDATE devlab.users AS u JOIN devlab.user_roles AS ur ON ur.user_id = u.user_id LEFT JOIN devlab.user_roles_students AS urs ON urs.user_role_id = ur.user_role_id JOIN devlab.teams AS t1 ON t1.team_id = urs.team_id SET u.displayname = t1.team_name",
+			nil,
+		},
+		{
+			"Updatex non-BaseTable",
+			Updatex(JoinedFrom(SelectOne().Subquery("sq"))),
+			"UPDATE NULL",
+			nil,
+		},
+		{
+			"Usingx",
+			DeleteFrom(t1).Usingx(ua).Where(t1.TEAM_ID.Eq(ua.URS.TEAM_ID)),
+			"DELETE FROM t1 USING devlab.users AS u JOIN devlab.user_roles AS ur ON ur.user_id = u.user_id LEFT JOIN devlab.user_roles_students AS urs ON urs.user_role_id = ur.user_role_id WHERE t1.team_id = urs.team_id",
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.q.AppendSQL(buf, &args, nil)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
@@ -6,15 +6,25 @@ import (
 	"strings"
 )
 
-// JSONField either represents a JSON column or a literal value that can be
-// marshalled into a JSON string.
+// JSONField either represents a JSON column, a JSON expression or a literal
+// value that can be marshalled into a JSON string.
 type JSONField struct {
 	// JSONField will be one of the following:
 
-	// 1) Literal JSONable value (almost all structs can be converted to JSON)
+	// 1) JSON expression
+	// Examples of JSON expressions:
+	// | query                    | args         |
+	// |--------------------------|--------------|
+	// | tbl.data->'$.address'    |              |
+	// | JSON_EXTRACT(?, ?, ?)    | {}, $.a, $.b |
+	// | JSON_SET(tbl.data, ?, ?) | $.a, 1       |
+	format *string
+	values []interface{}
+
+	// 2) Literal JSONable value (almost all structs can be converted to JSON)
 	value interface{}
 
-	// 2) JSON column
+	// 3) JSON column
 	alias      string
 	table      Table
 	name       string
@@ -26,12 +36,15 @@ type JSONField struct {
 // excludedTableQualifiers list.
 func (f JSONField) AppendSQLExclude(buf *strings.Builder, args *[]interface{}, params map[string]int, excludedTableQualifiers []string) {
 	switch {
+	case f.format != nil:
+		// 1) JSON expression
+		expandValues(buf, args, excludedTableQualifiers, *f.format, f.values)
 	case f.value != nil:
-		// 1) Literal JSONable value
+		// 2) Literal JSONable value
 		buf.WriteString("?")
 		*args = append(*args, f.value)
 	default:
-		// 2) JSON column
+		// 3) JSON column
 		tableQualifier := f.table.GetAlias()
 		if tableQualifier == "" {
 			tableQualifier = f.table.GetName()
@@ -156,6 +169,13 @@ func (f JSONField) Desc() JSONField {
 	return f
 }
 
+// ordering implements the orderedField interface.
+func (f JSONField) ordering() (field Field, descending *bool) {
+	descending = f.descending
+	f.descending = nil
+	return f, descending
+}
+
 // IsNull returns an 'X IS NULL' Predicate.
 func (f JSONField) IsNull() Predicate {
 	return CustomPredicate{
@@ -189,3 +209,190 @@ func (f JSONField) GetAlias() string {
 func (f JSONField) GetName() string {
 	return f.name
 }
+
+// quoteString returns s as a quoted SQL string literal. It is only used where
+// MySQL accepts a string literal but not a placeholder, such as the paths of a
+// JSON_TABLE and the SEPARATOR of GROUP_CONCAT. Backslashes are escaped, which
+// assumes the default sql_mode: with NO_BACKSLASH_ESCAPES a backslash in s
+// would be doubled.
+func quoteString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `'`, `''`)
+	return "'" + s + "'"
+}
+
+// jsonDocument returns the value to be used as a JSON document. Fields and
+// driver.Valuers are used as they are, []byte is taken to be raw JSON and
+// anything else is marshalled into JSON. It panics if the value cannot be
+// marshalled into JSON.
+func jsonDocument(value interface{}) interface{} {
+	switch v := value.(type) {
+	case interface {
+		AppendSQLExclude(*strings.Builder, *[]interface{}, map[string]int, []string)
+	}, interface {
+		AppendSQL(*strings.Builder, *[]interface{}, map[string]int)
+	}, driver.Valuer:
+		return value
+	case []byte:
+		return string(v)
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+// jsonValue returns the format and value to be stored in a JSON document.
+// Scalars are passed as they are, []byte is taken to be raw JSON and anything
+// else is marshalled into JSON. It panics if the value cannot be marshalled
+// into JSON.
+func jsonValue(value interface{}) (format string, v interface{}) {
+	switch value.(type) {
+	case nil, interface {
+		AppendSQLExclude(*strings.Builder, *[]interface{}, map[string]int, []string)
+	}, interface {
+		AppendSQL(*strings.Builder, *[]interface{}, map[string]int)
+	}, driver.Valuer, string, bool, int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64, float32, float64:
+		return "?", value
+	}
+	return "CAST(? AS JSON)", jsonDocument(value)
+}
+
+// jsonPaths returns the placeholders and values for a list of JSON paths that
+// follow the JSON document in a JSON function call. Each path gets its own
+// placeholder, because an empty slice would be rendered as a single NULL.
+func jsonPaths(f JSONField, paths []string) (format string, values []interface{}) {
+	values = make([]interface{}, 0, len(paths)+1)
+	values = append(values, f)
+	for _, path := range paths {
+		values = append(values, path)
+	}
+	return strings.Repeat(", ?", len(paths)), values
+}
+
+// Extract returns the data at the paths of the JSONField i.e.
+// 'JSON_EXTRACT(field, path...)'. If there are no paths, the JSONField is
+// returned as it is.
+func (f JSONField) Extract(paths ...string) JSONField {
+	if len(paths) == 0 {
+		return f
+	}
+	pathFormat, values := jsonPaths(f, paths)
+	format := "JSON_EXTRACT(?" + pathFormat + ")"
+	return JSONField{
+		format: &format,
+		values: values,
+	}
+}
+
+// Get returns the data at the path of the JSONField i.e.
+// 'JSON_EXTRACT(field, path)', which is what 'field->path' is shorthand for.
+// The -> operator is not used because it only accepts a string literal path,
+// while JSON_EXTRACT accepts the path as an argument.
+func (f JSONField) Get(path string) JSONField {
+	return f.Extract(path)
+}
+
+// GetText returns the unquoted data at the path of the JSONField i.e.
+// 'JSON_UNQUOTE(JSON_EXTRACT(field, path))', which is what 'field->>path' is
+// shorthand for.
+func (f JSONField) GetText(path string) StringField {
+	format := "JSON_UNQUOTE(JSON_EXTRACT(?, ?))"
+	return StringField{
+		format: &format,
+		values: []interface{}{f, path},
+	}
+}
+
+// Contains returns a 'JSON_CONTAINS(field, value)' Predicate. The value may be
+// a JSONField, raw JSON as a []byte or any value that can be marshalled into
+// JSON.
+func (f JSONField) Contains(value interface{}) Predicate {
+	return CustomPredicate{
+		Format: "JSON_CONTAINS(?, ?)",
+		Values: []interface{}{f, jsonDocument(value)},
+	}
+}
+
+// ContainsAt returns a 'JSON_CONTAINS(field, value, path)' Predicate. The
+// value may be a JSONField, raw JSON as a []byte or any value that can be
+// marshalled into JSON.
+func (f JSONField) ContainsAt(path string, value interface{}) Predicate {
+	return CustomPredicate{
+		Format: "JSON_CONTAINS(?, ?, ?)",
+		Values: []interface{}{f, jsonDocument(value), path},
+	}
+}
+
+// ContainsAnyPath returns a 'JSON_CONTAINS_PATH(field, 'one', path...)'
+// Predicate.
+func (f JSONField) ContainsAnyPath(paths ...string) Predicate {
+	pathFormat, values := jsonPaths(f, paths)
+	return CustomPredicate{
+		Format: "JSON_CONTAINS_PATH(?, 'one'" + pathFormat + ")",
+		Values: values,
+	}
+}
+
+// ContainsAllPaths returns a 'JSON_CONTAINS_PATH(field, 'all', path...)'
+// Predicate.
+func (f JSONField) ContainsAllPaths(paths ...string) Predicate {
+	pathFormat, values := jsonPaths(f, paths)
+	return CustomPredicate{
+		Format: "JSON_CONTAINS_PATH(?, 'all'" + pathFormat + ")",
+		Values: values,
+	}
+}
+
+// HasMember returns a 'value MEMBER OF(field)' Predicate, which checks if the
+// value is an element of the JSON array.
+func (f JSONField) HasMember(value interface{}) Predicate {
+	format, v := jsonValue(value)
+	return CustomPredicate{
+		Format: format + " MEMBER OF(?)",
+		Values: []interface{}{v, f},
+	}
+}
+
+// SetPath returns the JSONField with the value at the path replaced (or added,
+// if it is missing) i.e. 'JSON_SET(field, path, value)'. The value may be a
+// Field, raw JSON as a []byte or any value that can be marshalled into JSON.
+//
+//	Update(tbl).Set(tbl.DATA.Set(tbl.DATA.SetPath("$.a.b", 1)))
+func (f JSONField) SetPath(path string, value interface{}) JSONField {
+	valueFormat, v := jsonValue(value)
+	format := "JSON_SET(?, ?, " + valueFormat + ")"
+	return JSONField{
+		format: &format,
+		values: []interface{}{f, path, v},
+	}
+}
+
+// ReplacePath returns the JSONField with the existing value at the path
+// replaced i.e. 'JSON_REPLACE(field, path, value)'. The value may be a Field,
+// raw JSON as a []byte or any value that can be marshalled into JSON.
+func (f JSONField) ReplacePath(path string, value interface{}) JSONField {
+	valueFormat, v := jsonValue(value)
+	format := "JSON_REPLACE(?, ?, " + valueFormat + ")"
+	return JSONField{
+		format: &format,
+		values: []interface{}{f, path, v},
+	}
+}
+
+// RemovePath returns the JSONField with the values at the paths removed i.e.
+// 'JSON_REMOVE(field, path...)'. If there are no paths, the JSONField is
+// returned as it is.
+func (f JSONField) RemovePath(paths ...string) JSONField {
+	if len(paths) == 0 {
+		return f
+	}
+	pathFormat, values := jsonPaths(f, paths)
+	format := "JSON_REMOVE(?" + pathFormat + ")"
+	return JSONField{
+		format: &format,
+		values: values,
+	}
+}
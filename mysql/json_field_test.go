@@ -161,6 +161,60 @@ func TestJSONField_Predicates(t *testing.T) {
 			wantQuery := "`registered users`.`zip code` IS NOT NULL"
 			return TT{desc, p, nil, wantQuery, nil}
 		}(),
+		func() TT {
+			desc := "GetText"
+			p := NewJSONField("data", &TableInfo{Schema: "devlab", Name: "users"}).GetText("$.email").EqString("bob@email.com")
+			wantQuery := "JSON_UNQUOTE(JSON_EXTRACT(users.data, ?)) = ?"
+			return TT{desc, p, nil, wantQuery, []interface{}{"$.email", "bob@email.com"}}
+		}(),
+		func() TT {
+			desc := "GetText expression"
+			p := NewJSONField("data", &TableInfo{Schema: "devlab", Name: "users"}).Get("$.address").GetText("$.city").IsNull()
+			wantQuery := "JSON_UNQUOTE(JSON_EXTRACT(JSON_EXTRACT(users.data, ?), ?)) IS NULL"
+			return TT{desc, p, nil, wantQuery, []interface{}{"$.address", "$.city"}}
+		}(),
+		func() TT {
+			desc := "Contains"
+			p := NewJSONField("data", &TableInfo{Schema: "devlab", Name: "users"}).Contains(map[string]int{"a": 1})
+			wantQuery := "JSON_CONTAINS(users.data, ?)"
+			return TT{desc, p, nil, wantQuery, []interface{}{`{"a":1}`}}
+		}(),
+		func() TT {
+			desc := "ContainsAt"
+			p := NewJSONField("data", &TableInfo{Schema: "devlab", Name: "users"}).ContainsAt("$.tags", "a")
+			wantQuery := "JSON_CONTAINS(users.data, ?, ?)"
+			return TT{desc, p, nil, wantQuery, []interface{}{`"a"`, "$.tags"}}
+		}(),
+		func() TT {
+			desc := "ContainsAnyPath"
+			p := NewJSONField("data", &TableInfo{Schema: "devlab", Name: "users"}).ContainsAnyPath("$.a", "$.b")
+			wantQuery := "JSON_CONTAINS_PATH(users.data, 'one', ?, ?)"
+			return TT{desc, p, nil, wantQuery, []interface{}{"$.a", "$.b"}}
+		}(),
+		func() TT {
+			desc := "ContainsAllPaths"
+			p := NewJSONField("data", &TableInfo{Schema: "devlab", Name: "users"}).ContainsAllPaths("$.a")
+			wantQuery := "JSON_CONTAINS_PATH(users.data, 'all', ?)"
+			return TT{desc, p, nil, wantQuery, []interface{}{"$.a"}}
+		}(),
+		func() TT {
+			desc := "ContainsAnyPath no paths"
+			p := NewJSONField("data", &TableInfo{Schema: "devlab", Name: "users"}).ContainsAnyPath()
+			wantQuery := "JSON_CONTAINS_PATH(users.data, 'one')"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "HasMember"
+			p := NewJSONField("data", &TableInfo{Schema: "devlab", Name: "users"}).Get("$.tags").HasMember("a")
+			wantQuery := "? MEMBER OF(JSON_EXTRACT(users.data, ?))"
+			return TT{desc, p, nil, wantQuery, []interface{}{"a", "$.tags"}}
+		}(),
+		func() TT {
+			desc := "HasMember JSON"
+			p := NewJSONField("data", &TableInfo{Schema: "devlab", Name: "users"}).HasMember([]int{1, 2})
+			wantQuery := "CAST(? AS JSON) MEMBER OF(users.data)"
+			return TT{desc, p, nil, wantQuery, []interface{}{"[1,2]"}}
+		}(),
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -176,6 +230,39 @@ func TestJSONField_Predicates(t *testing.T) {
 	}
 }
 
+func TestJSONField_Functions(t *testing.T) {
+	type TT struct {
+		description string
+		f           Field
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	f := NewJSONField("data", &TableInfo{Schema: "devlab", Name: "users"})
+	tests := []TT{
+		{"Extract", f.Extract("$.a", "$.b"), "JSON_EXTRACT(users.data, ?, ?)", []interface{}{"$.a", "$.b"}},
+		{"Get", f.Get(`$."it's"`), "JSON_EXTRACT(users.data, ?)", []interface{}{`$."it's"`}},
+		{"SetPath", f.SetPath("$.a", 1), "JSON_SET(users.data, ?, ?)", []interface{}{"$.a", 1}},
+		{"SetPath JSON", f.SetPath("$.a", map[string]bool{"b": true}), "JSON_SET(users.data, ?, CAST(? AS JSON))", []interface{}{"$.a", `{"b":true}`}},
+		{"SetPath raw JSON", f.SetPath("$.a", []byte(`[1]`)), "JSON_SET(users.data, ?, CAST(? AS JSON))", []interface{}{"$.a", `[1]`}},
+		{"ReplacePath", f.ReplacePath("$.a", f.Get("$.b")), "JSON_REPLACE(users.data, ?, JSON_EXTRACT(users.data, ?))", []interface{}{"$.a", "$.b"}},
+		{"RemovePath", f.RemovePath("$.a", "$.b").SetPath("$.c", "c"), "JSON_SET(JSON_REMOVE(users.data, ?, ?), ?, ?)", []interface{}{"$.a", "$.b", "$.c", "c"}},
+		{"Extract no paths", f.Extract(), "users.data", nil},
+		{"RemovePath no paths", f.RemovePath().SetPath("$.c", "c"), "JSON_SET(users.data, ?, ?)", []interface{}{"$.c", "c"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.f.AppendSQLExclude(buf, &args, nil, nil)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
+
 type customValuer string
 
 func (v customValuer) Value() (driver.Value, error) {
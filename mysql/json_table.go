@@ -0,0 +1,127 @@
+package sq
+
+import "strings"
+
+// JSONTableInfo is a Table created by JSONTable. Its columns are declared with
+// the NumberColumn, StringColumn, BooleanColumn, TimeColumn, JSONColumn,
+// ExistsColumn and OrdinalityColumn methods, each of which returns a Field
+// that references the column.
+type JSONTableInfo struct {
+	alias   string
+	expr    interface{}
+	path    string
+	columns []jsonTableColumn
+}
+
+// jsonTableColumn is a column in the COLUMNS clause of a JSON_TABLE.
+type jsonTableColumn struct {
+	name       string
+	definition string
+}
+
+// JSONTable creates a new JSON_TABLE that turns the JSON document into rows,
+// one row for every match of the path. The expr may be a JSONField, raw JSON
+// as a []byte or any value that can be marshalled into JSON. MySQL requires
+// every JSON_TABLE to have an alias.
+//
+//	jt := JSONTable("jt", o.ITEMS, "$[*]")
+//	productID := jt.NumberColumn("product_id", "INT", "$.product_id")
+//	quantity := jt.NumberColumn("quantity", "INT", "$.quantity")
+//	From(o).CustomJoin("CROSS JOIN", jt).Select(o.ORDER_ID, productID, quantity)
+func JSONTable(alias string, expr interface{}, path string) *JSONTableInfo {
+	return &JSONTableInfo{
+		alias: alias,
+		expr:  jsonDocument(expr),
+		path:  path,
+	}
+}
+
+// AppendSQL marshals the JSONTableInfo into a buffer and an args slice.
+func (tbl *JSONTableInfo) AppendSQL(buf *strings.Builder, args *[]interface{}, params map[string]int) {
+	buf.WriteString("JSON_TABLE(")
+	appendSQLValue(buf, args, nil, tbl.expr)
+	buf.WriteString(", ")
+	buf.WriteString(quoteString(tbl.path))
+	buf.WriteString(" COLUMNS(")
+	for i, column := range tbl.columns {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		if strings.ContainsAny(column.name, " \t") {
+			buf.WriteString("`")
+			buf.WriteString(column.name)
+			buf.WriteString("`")
+		} else {
+			buf.WriteString(column.name)
+		}
+		buf.WriteString(" ")
+		buf.WriteString(column.definition)
+	}
+	buf.WriteString("))")
+}
+
+// GetAlias implements the Table interface. It returns the alias of the
+// JSONTableInfo.
+func (tbl *JSONTableInfo) GetAlias() string {
+	return tbl.alias
+}
+
+// GetName implements the Table interface. It always returns an empty string,
+// as a JSON_TABLE can only be referred to by its alias.
+func (tbl *JSONTableInfo) GetName() string {
+	return ""
+}
+
+// column adds a column to the COLUMNS clause.
+func (tbl *JSONTableInfo) column(name, definition string) {
+	tbl.columns = append(tbl.columns, jsonTableColumn{name: name, definition: definition})
+}
+
+// NumberColumn adds a 'name sqlType PATH path' column and returns it as a
+// NumberField.
+func (tbl *JSONTableInfo) NumberColumn(name, sqlType, path string) NumberField {
+	tbl.column(name, sqlType+" PATH "+quoteString(path))
+	return NewNumberField(name, tbl)
+}
+
+// StringColumn adds a 'name sqlType PATH path' column and returns it as a
+// StringField.
+func (tbl *JSONTableInfo) StringColumn(name, sqlType, path string) StringField {
+	tbl.column(name, sqlType+" PATH "+quoteString(path))
+	return NewStringField(name, tbl)
+}
+
+// BooleanColumn adds a 'name BOOLEAN PATH path' column and returns it as a
+// BooleanField.
+func (tbl *JSONTableInfo) BooleanColumn(name, path string) BooleanField {
+	tbl.column(name, "BOOLEAN PATH "+quoteString(path))
+	return NewBooleanField(name, tbl)
+}
+
+// TimeColumn adds a 'name sqlType PATH path' column and returns it as a
+// TimeField.
+func (tbl *JSONTableInfo) TimeColumn(name, sqlType, path string) TimeField {
+	tbl.column(name, sqlType+" PATH "+quoteString(path))
+	return NewTimeField(name, tbl)
+}
+
+// JSONColumn adds a 'name JSON PATH path' column and returns it as a
+// JSONField.
+func (tbl *JSONTableInfo) JSONColumn(name, path string) JSONField {
+	tbl.column(name, "JSON PATH "+quoteString(path))
+	return NewJSONField(name, tbl)
+}
+
+// ExistsColumn adds a 'name INT EXISTS PATH path' column, which is 1 if the
+// path matches anything and 0 otherwise, and returns it as a NumberField.
+func (tbl *JSONTableInfo) ExistsColumn(name, path string) NumberField {
+	tbl.column(name, "INT EXISTS PATH "+quoteString(path))
+	return NewNumberField(name, tbl)
+}
+
+// OrdinalityColumn adds a 'name FOR ORDINALITY' column, which numbers the rows
+// starting from 1, and returns it as a NumberField.
+func (tbl *JSONTableInfo) OrdinalityColumn(name string) NumberField {
+	tbl.column(name, "FOR ORDINALITY")
+	return NewNumberField(name, tbl)
+}
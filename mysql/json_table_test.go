@@ -0,0 +1,63 @@
+package sq
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestJSONTable(t *testing.T) {
+	is := is.New(t)
+	a := APPLICATIONS()
+
+	// JSON column
+	jt := JSONTable("jt", a.APPLICATION_DATA, "$.members[*]")
+	ord := jt.OrdinalityColumn("ord")
+	name := jt.StringColumn("name", "VARCHAR(255)", "$.name")
+	age := jt.NumberColumn("age", "INT", "$.age")
+	admin := jt.BooleanColumn("admin", "$.admin")
+	joined := jt.TimeColumn("joined", "DATETIME", "$.joined")
+	tags := jt.JSONColumn("tags", "$.tags")
+	hasEmail := jt.ExistsColumn("has_email", "$.email")
+	gotQuery, gotArgs := From(a).
+		CustomJoin("CROSS JOIN", jt).
+		Where(age.GtInt(18), admin, tags.HasMember("x")).
+		Select(a.APPLICATION_ID, ord, name, joined, hasEmail).
+		ToSQL()
+	is.Equal("SELECT applications.application_id, jt.ord, jt.name, jt.joined, jt.has_email"+
+		" FROM devlab.applications"+
+		" CROSS JOIN JSON_TABLE(applications.application_data, '$.members[*]' COLUMNS("+
+		"ord FOR ORDINALITY"+
+		", name VARCHAR(255) PATH '$.name'"+
+		", age INT PATH '$.age'"+
+		", admin BOOLEAN PATH '$.admin'"+
+		", joined DATETIME PATH '$.joined'"+
+		", tags JSON PATH '$.tags'"+
+		", has_email INT EXISTS PATH '$.email'"+
+		")) AS jt"+
+		" WHERE jt.age > ? AND jt.admin AND ? MEMBER OF(jt.tags)", gotQuery)
+	is.Equal([]interface{}{18, "x"}, gotArgs)
+
+	// Literal JSON
+	jt = JSONTable("numbers", []int{1, 2, 3}, "$[*]")
+	n := jt.NumberColumn("n", "INT", "$")
+	gotQuery, gotArgs = From(jt).Select(n).ToSQL()
+	is.Equal("SELECT numbers.n FROM JSON_TABLE(?, '$[*]' COLUMNS(n INT PATH '$')) AS numbers", gotQuery)
+	is.Equal([]interface{}{"[1,2,3]"}, gotArgs)
+
+	if testing.Short() {
+		return
+	}
+	db, err := sql.Open("txdb", "JSONTable")
+	is.NoErr(err)
+	defer db.Close()
+	var numbers []int
+	var num int
+	err = From(jt).
+		OrderBy(n.Desc()).
+		Selectx(func(row *Row) { num = row.Int(n) }, func() { numbers = append(numbers, num) }).
+		Fetch(db)
+	is.NoErr(err)
+	is.Equal([]int{3, 2, 1}, numbers)
+}
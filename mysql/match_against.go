@@ -0,0 +1,107 @@
+package sq
+
+import "strings"
+
+// MatchAgainstPredicate is a FULLTEXT search i.e. 'MATCH (col1, col2) AGAINST
+// (query)'. In WHERE it is a Predicate that filters out the rows that do not
+// match the query, and in SELECT or ORDER BY its Score is the relevance of the
+// row. The fields must match the column list of a FULLTEXT index exactly.
+type MatchAgainstPredicate struct {
+	alias    string
+	fields   []StringField
+	query    string
+	modifier string
+	negative bool
+}
+
+// MatchAgainst creates a new FULLTEXT search for the query over the fields.
+// The query is searched for in natural language mode, unless InBooleanMode or
+// WithQueryExpansion is called.
+//
+//	m := MatchAgainst("+mysql -oracle", p.TITLE, p.BODY).InBooleanMode()
+//	From(p).Where(m).OrderBy(m.Score().Desc()).Select(p.POST_ID, m.Score().As("score"))
+func MatchAgainst(query string, fields ...StringField) MatchAgainstPredicate {
+	return MatchAgainstPredicate{
+		fields: fields,
+		query:  query,
+	}
+}
+
+// AppendSQLExclude marshals the MatchAgainstPredicate into a buffer and an
+// args slice.
+func (p MatchAgainstPredicate) AppendSQLExclude(buf *strings.Builder, args *[]interface{}, params map[string]int, excludedTableQualifiers []string) {
+	if p.negative {
+		buf.WriteString("NOT ")
+	}
+	buf.WriteString("MATCH (")
+	for i, field := range p.fields {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		field.AppendSQLExclude(buf, args, nil, excludedTableQualifiers)
+	}
+	buf.WriteString(") AGAINST (?")
+	*args = append(*args, p.query)
+	if p.modifier != "" {
+		buf.WriteString(" ")
+		buf.WriteString(p.modifier)
+	}
+	buf.WriteString(")")
+}
+
+// InNaturalLanguageMode searches for the query as natural language, which is
+// the default search mode i.e. 'AGAINST (query IN NATURAL LANGUAGE MODE)'.
+// Rows that are more relevant to the query have a higher Score.
+func (p MatchAgainstPredicate) InNaturalLanguageMode() MatchAgainstPredicate {
+	p.modifier = "IN NATURAL LANGUAGE MODE"
+	return p
+}
+
+// InBooleanMode searches for the query using the boolean search operators e.g.
+// '+apple -banana "exact phrase" orange*' i.e. 'AGAINST (query IN BOOLEAN
+// MODE)'.
+func (p MatchAgainstPredicate) InBooleanMode() MatchAgainstPredicate {
+	p.modifier = "IN BOOLEAN MODE"
+	return p
+}
+
+// WithQueryExpansion searches for the query as natural language, then searches
+// again with the words from the most relevant rows added to the query i.e.
+// 'AGAINST (query WITH QUERY EXPANSION)'. This is useful for short queries, but
+// it can return a lot of unrelated rows.
+func (p MatchAgainstPredicate) WithQueryExpansion() MatchAgainstPredicate {
+	p.modifier = "WITH QUERY EXPANSION"
+	return p
+}
+
+// Score returns the relevance of the row to the search query as a
+// NumberField, for use in SELECT or ORDER BY. MySQL only computes the
+// relevance once if the same MATCH ... AGAINST also appears in the WHERE
+// clause.
+func (p MatchAgainstPredicate) Score() NumberField {
+	p.alias, p.negative = "", false
+	return NumberFieldf("?", p)
+}
+
+// As aliases the MatchAgainstPredicate.
+func (p MatchAgainstPredicate) As(alias string) MatchAgainstPredicate {
+	p.alias = alias
+	return p
+}
+
+// Not inverts the MatchAgainstPredicate i.e. 'NOT MATCH (...) AGAINST (...)'.
+func (p MatchAgainstPredicate) Not() Predicate {
+	p.negative = !p.negative
+	return p
+}
+
+// GetAlias returns the alias of the MatchAgainstPredicate.
+func (p MatchAgainstPredicate) GetAlias() string {
+	return p.alias
+}
+
+// GetName returns the name of the MatchAgainstPredicate, which is always an
+// empty string.
+func (p MatchAgainstPredicate) GetName() string {
+	return ""
+}
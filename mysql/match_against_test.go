@@ -0,0 +1,84 @@
+package sq
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestMatchAgainst(t *testing.T) {
+	type TT struct {
+		description string
+		f           Field
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	a := APPLICATIONS()
+	tests := []TT{
+		{
+			"natural language mode",
+			MatchAgainst("chat app", a.TEAM_NAME, a.PROJECT_IDEA).InNaturalLanguageMode(),
+			"MATCH (applications.team_name, applications.project_idea) AGAINST (? IN NATURAL LANGUAGE MODE)",
+			[]interface{}{"chat app"},
+		},
+		{
+			"boolean mode",
+			MatchAgainst("+chat -game", a.PROJECT_IDEA).InBooleanMode(),
+			"MATCH (applications.project_idea) AGAINST (? IN BOOLEAN MODE)",
+			[]interface{}{"+chat -game"},
+		},
+		{
+			"query expansion",
+			MatchAgainst("database", a.PROJECT_IDEA).WithQueryExpansion(),
+			"MATCH (applications.project_idea) AGAINST (? WITH QUERY EXPANSION)",
+			[]interface{}{"database"},
+		},
+		{
+			"Not",
+			Not(MatchAgainst("game", a.PROJECT_IDEA).InBooleanMode()),
+			"NOT MATCH (applications.project_idea) AGAINST (? IN BOOLEAN MODE)",
+			[]interface{}{"game"},
+		},
+		{
+			"Score",
+			MatchAgainst("game", a.PROJECT_IDEA).InBooleanMode().As("m").Not().(MatchAgainstPredicate).Score().Desc(),
+			"MATCH (applications.project_idea) AGAINST (? IN BOOLEAN MODE) DESC",
+			[]interface{}{"game"},
+		},
+		{
+			"default mode",
+			MatchAgainst("chat app", a.TEAM_NAME, a.PROJECT_IDEA),
+			"MATCH (applications.team_name, applications.project_idea) AGAINST (?)",
+			[]interface{}{"chat app"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.f.AppendSQLExclude(buf, &args, nil, nil)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+
+	t.Run("SelectQuery", func(t *testing.T) {
+		is := is.New(t)
+		m := MatchAgainst("chat app", a.TEAM_NAME, a.PROJECT_IDEA).InNaturalLanguageMode()
+		gotQuery, gotArgs := From(a).
+			Where(m).
+			OrderBy(m.Score().Desc()).
+			Select(a.APPLICATION_ID, m.Score().As("score")).
+			ToSQL()
+		is.Equal("SELECT applications.application_id"+
+			", MATCH (applications.team_name, applications.project_idea) AGAINST (? IN NATURAL LANGUAGE MODE) AS score"+
+			" FROM devlab.applications"+
+			" WHERE MATCH (applications.team_name, applications.project_idea) AGAINST (? IN NATURAL LANGUAGE MODE)"+
+			" ORDER BY MATCH (applications.team_name, applications.project_idea) AGAINST (? IN NATURAL LANGUAGE MODE) DESC", gotQuery)
+		is.Equal([]interface{}{"chat app", "chat app", "chat app"}, gotArgs)
+	})
+}
@@ -1,6 +1,9 @@
 package sq
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+)
 
 // NumberField either represents a number column, a number expression or a
 // literal number value.
@@ -14,8 +17,9 @@ type NumberField struct {
 	// | ? / ?                  | 22, 7       |
 	// | FLOOR(? + tbl.column)  | 5           |
 	// | (ABS(?) + (? % ?)) - ? | -3, 5, 4, 8 |
-	format *string
-	values []interface{}
+	format   *string
+	values   []interface{}
+	operator int
 
 	// 2) Literal number value
 	// Examples of literal number values:
@@ -176,6 +180,13 @@ func (f NumberField) Desc() NumberField {
 	return f
 }
 
+// ordering implements the orderedField interface.
+func (f NumberField) ordering() (field Field, descending *bool) {
+	descending = f.descending
+	f.descending = nil
+	return f, descending
+}
+
 // IsNull returns an 'X IS NULL' Predicate.
 func (f NumberField) IsNull() Predicate {
 	return CustomPredicate{
@@ -351,6 +362,149 @@ func (f NumberField) In(v interface{}) Predicate {
 	}
 }
 
+// SetExpr returns a FieldAssignment associating the NumberField to the number
+// expression i.e. 'field = expr'. Unlike Set, the expression must be a
+// NumberField.
+//
+//	Update(u).Set(u.BALANCE.SetExpr(u.BALANCE.Add(Int(5))))
+func (f NumberField) SetExpr(expr NumberField) FieldAssignment {
+	return FieldAssignment{
+		Field: f,
+		Value: expr,
+	}
+}
+
+// Add returns a new NumberField that is the NumberField plus the value, which
+// may be a NumberField or a Go number i.e. 'field + value'.
+func (f NumberField) Add(value interface{}) NumberField {
+	return f.binaryOperation("+", operatorAdditive, value)
+}
+
+// Sub returns a new NumberField that is the NumberField minus the value, which
+// may be a NumberField or a Go number i.e. 'field - value'.
+func (f NumberField) Sub(value interface{}) NumberField {
+	return f.binaryOperation("-", operatorAdditive, value)
+}
+
+// Mul returns a new NumberField that is the NumberField multiplied by the
+// value, which may be a NumberField or a Go number i.e. 'field * value'.
+func (f NumberField) Mul(value interface{}) NumberField {
+	return f.binaryOperation("*", operatorMultiplicative, value)
+}
+
+// Div returns a new NumberField that is the NumberField divided by the value,
+// which may be a NumberField or a Go number i.e. 'field / value'. The result
+// is always a decimal, even if both operands are integers.
+func (f NumberField) Div(value interface{}) NumberField {
+	return f.binaryOperation("/", operatorMultiplicative, value)
+}
+
+// Mod returns a new NumberField that is the remainder of the NumberField
+// divided by the value, which may be a NumberField or a Go number i.e. 'field
+// % value'.
+func (f NumberField) Mod(value interface{}) NumberField {
+	return f.binaryOperation("%", operatorMultiplicative, value)
+}
+
+// Neg returns a new NumberField that is the negation of the NumberField i.e.
+// '-field'.
+func (f NumberField) Neg() NumberField {
+	format := "-?"
+	if f.operator != operatorNone || f.value != nil {
+		format = "-(?)"
+	}
+	return NumberField{
+		format:   &format,
+		values:   []interface{}{f},
+		operator: operatorUnary,
+	}
+}
+
+// Abs returns a new NumberField that is the absolute value of the NumberField
+// i.e. 'ABS(field)'.
+func (f NumberField) Abs() NumberField {
+	format := "ABS(?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Round returns a new NumberField that is the NumberField rounded to the
+// number of decimal places i.e. 'ROUND(field, places)'.
+func (f NumberField) Round(places int) NumberField {
+	format := "ROUND(?)"
+	if places != 0 {
+		format = "ROUND(?, " + strconv.Itoa(places) + ")"
+	}
+	return NumberField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Ceil returns a new NumberField that is the NumberField rounded up to the
+// nearest integer i.e. 'CEIL(field)'.
+func (f NumberField) Ceil() NumberField {
+	format := "CEIL(?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Floor returns a new NumberField that is the NumberField rounded down to the
+// nearest integer i.e. 'FLOOR(field)'.
+func (f NumberField) Floor() NumberField {
+	format := "FLOOR(?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Power returns a new NumberField that is the NumberField raised to the
+// exponent, which may be a NumberField or a Go number i.e. 'POWER(field,
+// exponent)'.
+func (f NumberField) Power(exponent interface{}) NumberField {
+	format := "POWER(?, ?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{f, exponent},
+	}
+}
+
+// The operators of a number expression, from the one that binds the tightest
+// to the one that binds the loosest. They decide if a number expression needs
+// to be bracketed when it is used as an operand.
+const (
+	operatorNone           = iota // columns, literals and function calls
+	operatorUnary                 // -x
+	operatorMultiplicative        // x * y, x / y, x % y
+	operatorAdditive              // x + y, x - y
+	operatorUnknown               // NumberFieldf, which could be anything
+)
+
+// binaryOperation returns a new NumberField of the left-associative operator
+// applied to the NumberField and the value. The NumberField is bracketed if
+// its operator binds looser than the operator, while a NumberField value is
+// also bracketed if its operator binds just as loosely e.g. 'a - (b - c)'.
+func (f NumberField) binaryOperation(operator string, precedence int, value interface{}) NumberField {
+	left, right := "?", "?"
+	if f.operator > precedence {
+		left = "(?)"
+	}
+	if v, ok := value.(NumberField); ok && v.operator >= precedence {
+		right = "(?)"
+	}
+	format := left + " " + operator + " " + right
+	return NumberField{
+		format:   &format,
+		values:   []interface{}{f, value},
+		operator: precedence,
+	}
+}
+
 // String returns the string representation of the NumberField.
 func (f NumberField) String() string {
 	buf := &strings.Builder{}
@@ -372,7 +526,8 @@ func (f NumberField) GetName() string {
 // NumberFieldf creates a new number expression.
 func NumberFieldf(format string, values ...interface{}) NumberField {
 	return NumberField{
-		format: &format,
-		values: values,
+		format:   &format,
+		values:   values,
+		operator: operatorUnknown,
 	}
 }
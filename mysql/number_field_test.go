@@ -347,3 +347,56 @@ func TestNumberField_Predicates(t *testing.T) {
 		})
 	}
 }
+
+func TestNumberField_Arithmetic(t *testing.T) {
+	type TT struct {
+		description string
+		f           NumberField
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	tbl := &TableInfo{Schema: "devlab", Name: "orders"}
+	price := NewNumberField("price", tbl)
+	quantity := NewNumberField("quantity", tbl)
+	discount := NewNumberField("discount", tbl)
+	tests := []TT{
+		{"Mul", price.Mul(quantity), "orders.price * orders.quantity", nil},
+		{"Add Go number", price.Add(5), "orders.price + ?", []interface{}{5}},
+		{"Sub literal", price.Sub(Float64(0.5)), "orders.price - ?", []interface{}{0.5}},
+		{"additive inside multiplicative", price.Sub(discount).Mul(quantity), "(orders.price - orders.discount) * orders.quantity", nil},
+		{"multiplicative inside additive", price.Mul(quantity).Sub(discount), "orders.price * orders.quantity - orders.discount", nil},
+		{"right operand of the same precedence", price.Sub(quantity.Sub(discount)), "orders.price - (orders.quantity - orders.discount)", nil},
+		{"left operand of the same precedence", price.Sub(quantity).Sub(discount), "orders.price - orders.quantity - orders.discount", nil},
+		{"Div Mod", price.Div(quantity.Mod(2)), "orders.price / (orders.quantity % ?)", []interface{}{2}},
+		{"Neg column", price.Neg(), "-orders.price", nil},
+		{"Neg expression", price.Add(discount).Neg().Mul(2), "-(orders.price + orders.discount) * ?", []interface{}{2}},
+		{"Neg literal", Int(-5).Neg(), "-(?)", []interface{}{-5}},
+		{"NumberFieldf operand", NumberFieldf("? + ?", price, 1).Mul(2), "(orders.price + ?) * ?", []interface{}{1, 2}},
+		{"Abs", price.Sub(discount).Abs(), "ABS(orders.price - orders.discount)", nil},
+		{"Round", price.Mul(quantity).Round(2), "ROUND(orders.price * orders.quantity, 2)", nil},
+		{"Round 0 places", price.Round(0), "ROUND(orders.price)", nil},
+		{"Ceil Floor", price.Ceil().Sub(price.Floor()), "CEIL(orders.price) - FLOOR(orders.price)", nil},
+		{"Power", price.Power(quantity.Add(1)), "POWER(orders.price, orders.quantity + ?)", []interface{}{1}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.f.AppendSQLExclude(buf, &args, nil, nil)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+
+	t.Run("SetExpr", func(t *testing.T) {
+		is := is.New(t)
+		buf := &strings.Builder{}
+		var args []interface{}
+		price.SetExpr(price.Mul(1.1).Round(2)).AppendSQLExclude(buf, &args, nil, nil)
+		is.Equal("orders.price = ROUND(orders.price * ?, 2)", buf.String())
+		is.Equal([]interface{}{1.1}, args)
+	})
+}
@@ -2,14 +2,9 @@ package sq
 
 import (
 	"context"
-	"database/sql"
-	"errors"
 	"fmt"
 	"log"
-	"reflect"
-	"strconv"
 	"strings"
-	"time"
 )
 
 // SelectType represents the various SQL selects.
@@ -54,6 +49,7 @@ type SelectQuery struct {
 	// Logging
 	Log     Logger
 	LogFlag LogFlag
+	LogFunc LogFunc
 	logSkip int
 }
 
@@ -63,6 +59,15 @@ func (q SelectQuery) ToSQL() (string, []interface{}) {
 	buf := &strings.Builder{}
 	var args []interface{}
 	q.AppendSQL(buf, &args, nil)
+	if q.LogFunc != nil {
+		q.LogFunc(LogInfo{
+			LogFlag: q.LogFlag,
+			LogSkip: q.logSkip + 2,
+			Query:   buf.String(),
+			Args:    args,
+			Action:  LogActionToSQL,
+		})
+	}
 	return buf.String(), args
 }
 
@@ -177,6 +182,11 @@ func From(table Table) SelectQuery {
 	}
 }
 
+// Fromx creates a new SelectQuery from the JoinedTables.
+func Fromx(joined JoinedTablesGetter) SelectQuery {
+	return From(nil).Fromx(joined)
+}
+
 // Select creates a new SelectQuery.
 func Select(fields ...Field) SelectQuery {
 	return SelectQuery{
@@ -262,6 +272,15 @@ func (q SelectQuery) From(table Table) SelectQuery {
 	return q
 }
 
+// Fromx sets the FROM table of the SelectQuery to the JoinedTables' FROM
+// table, followed by its JoinTables.
+func (q SelectQuery) Fromx(joined JoinedTablesGetter) SelectQuery {
+	jt := joined.GetJoinedTables()
+	q.FromTable = jt.FromTable
+	q.JoinTables = jt.prependJoins(q.JoinTables)
+	return q
+}
+
 // Join joins a new table to the SelectQuery based on the predicates.
 func (q SelectQuery) Join(table Table, predicate Predicate, predicates ...Predicate) SelectQuery {
 	predicates = append([]Predicate{predicate}, predicates...)
@@ -395,121 +414,52 @@ func (q SelectQuery) Fetch(db DB) (err error) {
 // maps the results based on the mapper function (and optionally runs the
 // accumulator function).
 func (q SelectQuery) FetchContext(ctx context.Context, db DB) (err error) {
-	if db == nil {
-		if q.DB == nil {
-			return errors.New("DB cannot be nil")
+	q.logSkip += 1
+	cq := q.compiledQuery()
+	q.Log = nil // the query is logged by the CompiledQuery
+	if fields, ok := mapperFields(q.RowMapper); ok {
+		q.SelectFields = fields
+		if len(q.SelectFields) == 0 {
+			q.SelectFields = Fields{FieldLiteral("1")}
 		}
-		db = q.DB
+		buf := &strings.Builder{}
+		q.AppendSQL(buf, &cq.Args, nil)
+		cq.Query = buf.String()
 	}
-	if q.RowMapper == nil {
-		return fmt.Errorf("cannot call Fetch/FetchContext without a mapper")
+	return cq.fetchContext(ctx, db)
+}
+
+// compiledQuery returns a CompiledQuery that shares the SelectQuery's DB, mapper
+// and logging settings, so that the SelectQuery can be run by the same
+// fetchContext as a CompiledQuery once its query string and args have been
+// generated.
+func (q SelectQuery) compiledQuery() CompiledQuery {
+	return CompiledQuery{
+		DB:          q.DB,
+		RowMapper:   q.RowMapper,
+		Accumulator: q.Accumulator,
+		Log:         q.Log,
+		LogFlag:     q.LogFlag,
+		LogFunc:     q.LogFunc,
+		logSkip:     q.logSkip,
+	}
+}
+
+// mapperFields runs the mapper on an empty Row and returns the fields that it
+// scans. It reports false if there is no mapper or if the mapper panicked, in
+// which case fetchContext reports the error when it runs the mapper again.
+func mapperFields(mapper func(*Row)) (fields []Field, ok bool) {
+	if mapper == nil {
+		return nil, false
 	}
-	logBuf := &strings.Builder{}
-	start := time.Now()
-	var rowcount int
 	defer func() {
 		if r := recover(); r != nil {
-			switch v := r.(type) {
-			case ExitCode:
-				if v != ExitPeacefully {
-					err = v
-				}
-			case error:
-				err = v
-			default:
-				err = fmt.Errorf("%#v", r)
-			}
-			return
-		}
-		if q.Log == nil {
-			return
-		}
-		elapsed := time.Since(start)
-		if Lresults&q.LogFlag != 0 && rowcount > 5 {
-			logBuf.WriteString("\n...")
-		}
-		if Lstats&q.LogFlag != 0 {
-			logBuf.WriteString("\n(Fetched ")
-			logBuf.WriteString(strconv.Itoa(rowcount))
-			logBuf.WriteString(" rows in ")
-			logBuf.WriteString(elapsed.String())
-			logBuf.WriteString(")")
-		}
-		if logBuf.Len() > 0 {
-			switch q.Log.(type) {
-			case *log.Logger:
-				_ = q.Log.Output(q.logSkip+2, logBuf.String())
-			default:
-				_ = q.Log.Output(q.logSkip+1, logBuf.String())
-			}
+			ok = false
 		}
 	}()
 	r := &Row{}
-	q.RowMapper(r)
-	q.SelectFields = r.fields
-	if len(q.SelectFields) == 0 {
-		q.SelectFields = Fields{FieldLiteral("1")}
-	}
-	tmpbuf := &strings.Builder{}
-	var tmpargs []interface{}
-	q.logSkip += 1
-	q.AppendSQL(tmpbuf, &tmpargs, nil)
-	if ctx == nil {
-		r.rows, err = db.Query(tmpbuf.String(), tmpargs...)
-	} else {
-		r.rows, err = db.QueryContext(ctx, tmpbuf.String(), tmpargs...)
-	}
-	if err != nil {
-		return err
-	}
-	defer r.rows.Close()
-	if len(r.dest) == 0 {
-		return nil
-	}
-	for r.rows.Next() {
-		rowcount++
-		err = r.rows.Scan(r.dest...)
-		if err != nil {
-			errbuf := &strings.Builder{}
-			for i := range r.dest {
-				tmpbuf.Reset()
-				tmpargs = tmpargs[:0]
-				r.fields[i].AppendSQLExclude(tmpbuf, &tmpargs, nil, nil)
-				errbuf.WriteString("\n" +
-					strconv.Itoa(i) + ") " +
-					questionInterpolate(tmpbuf.String(), tmpargs...) + " => " +
-					reflect.TypeOf(r.dest[i]).String())
-			}
-			return fmt.Errorf("Please check if your mapper function is correct:%s\n%w", errbuf.String(), err)
-		}
-		if q.Log != nil && Lresults&q.LogFlag != 0 && rowcount <= 5 {
-			logBuf.WriteString("\n----[ Row ")
-			logBuf.WriteString(strconv.Itoa(rowcount))
-			logBuf.WriteString(" ]----")
-			for i := range r.dest {
-				tmpbuf.Reset()
-				tmpargs = tmpargs[:0]
-				r.fields[i].AppendSQLExclude(tmpbuf, &tmpargs, nil, nil)
-				logBuf.WriteString("\n")
-				logBuf.WriteString(questionInterpolate(tmpbuf.String(), tmpargs...))
-				logBuf.WriteString(": ")
-				appendSQLDisplay(logBuf, r.dest[i])
-			}
-		}
-		r.index = 0
-		q.RowMapper(r)
-		if q.Accumulator == nil {
-			break
-		}
-		q.Accumulator()
-	}
-	if rowcount == 0 && q.Accumulator == nil {
-		return sql.ErrNoRows
-	}
-	if e := r.rows.Close(); e != nil {
-		return e
-	}
-	return r.rows.Err()
+	mapper(r)
+	return r.fields, true
 }
 
 // NestThis indicates to the SelectQuery that it is nested.
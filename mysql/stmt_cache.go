@@ -0,0 +1,31 @@
+package sq
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bokwoon95/go-structured-query/internal/stmtcache"
+)
+
+// PreparerDB is a DB that can prepare statements, e.g. *sql.DB, *sql.Tx or
+// *sql.Conn.
+type PreparerDB interface {
+	DB
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// StmtCache is a DB that prepares every query on first use and reuses the
+// prepared statement whenever the same query is run again, so that the
+// database does not have to re-plan frequently run queries. Statements are
+// keyed by their final SQL text. Once the cache is full the least recently
+// used statement is closed to make room for a new one.
+//
+// A StmtCache is safe for concurrent use. It does not implement BeginTx, so
+// pass the underlying DB to Tx instead.
+type StmtCache = stmtcache.Cache
+
+// NewStmtCache creates a new StmtCache that holds up to maxSize prepared
+// statements. If maxSize is zero or less, the cache is unbounded.
+func NewStmtCache(db PreparerDB, maxSize int) *StmtCache {
+	return stmtcache.New(db, maxSize)
+}
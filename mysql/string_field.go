@@ -1,6 +1,9 @@
 package sq
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+)
 
 // EnumField is a type alias for StringField.
 type EnumField = StringField
@@ -10,19 +13,29 @@ func NewEnumField(name string, table Table) EnumField {
 	return NewStringField(name, table)
 }
 
-// StringField either represents a string column or a literal string value.
+// StringField either represents a string column, a string expression or a
+// literal string value.
 type StringField struct {
 	// StringField will be one of the following:
 
-	// 1) Literal string value
+	// 1) String expression
+	// Examples of string expressions:
+	// | query                            | args    |
+	// |----------------------------------|---------|
+	// | tbl.data->>'$.email'             |         |
+	// | JSON_UNQUOTE(JSON_EXTRACT(?, ?)) | {}, $.a |
+	format *string
+	values []interface{}
+
+	// 2) Literal string value
 	// Examples of literal string values:
 	// | query | args |
 	// |-------|------|
 	// | ?     | abcd |
 	value *string
 
-	// 2) String column
-	// Examples of boolean columns:
+	// 3) String column
+	// Examples of string columns:
 	// | query       | args |
 	// |-------------|------|
 	// | users.name  |      |
@@ -39,12 +52,15 @@ type StringField struct {
 // excludedTableQualifiers list.
 func (f StringField) AppendSQLExclude(buf *strings.Builder, args *[]interface{}, params map[string]int, excludedTableQualifiers []string) {
 	switch {
+	case f.format != nil:
+		// 1) String expression
+		expandValues(buf, args, excludedTableQualifiers, *f.format, f.values)
 	case f.value != nil:
-		// 1) Literal string value
+		// 2) Literal string value
 		buf.WriteString("?")
 		*args = append(*args, *f.value)
 	default:
-		// 2) String column
+		// 3) String column
 		tableQualifier := f.table.GetAlias()
 		if tableQualifier == "" {
 			tableQualifier = f.table.GetName()
@@ -137,6 +153,13 @@ func (f StringField) Desc() StringField {
 	return f
 }
 
+// ordering implements the orderedField interface.
+func (f StringField) ordering() (field Field, descending *bool) {
+	descending = f.descending
+	f.descending = nil
+	return f, descending
+}
+
 // IsNull returns an 'X IS NULL' Predicate.
 func (f StringField) IsNull() Predicate {
 	return CustomPredicate{
@@ -280,6 +303,172 @@ func (f StringField) In(v interface{}) Predicate {
 	}
 }
 
+// Lower returns a new StringField converted to lowercase i.e. 'LOWER(field)'.
+func (f StringField) Lower() StringField {
+	format := "LOWER(?)"
+	return StringField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Upper returns a new StringField converted to uppercase i.e. 'UPPER(field)'.
+func (f StringField) Upper() StringField {
+	format := "UPPER(?)"
+	return StringField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Trim returns a new StringField with the leading and trailing spaces removed
+// i.e. 'TRIM(field)'.
+func (f StringField) Trim() StringField {
+	format := "TRIM(?)"
+	return StringField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Concat returns a new StringField that is the StringField followed by the
+// values i.e. 'CONCAT(field, value1, value2)'. The result is NULL if any of
+// the values are NULL.
+func (f StringField) Concat(values ...interface{}) StringField {
+	format := "CONCAT(?" + strings.Repeat(", ?", len(values)) + ")"
+	return StringField{
+		format: &format,
+		values: append([]interface{}{f}, values...),
+	}
+}
+
+// Substring returns a new StringField of length characters starting from the
+// start character, where the first character is 1 i.e. 'SUBSTRING(field,
+// start, length)'.
+func (f StringField) Substring(start, length int) StringField {
+	format := "SUBSTRING(?, " + strconv.Itoa(start) + ", " + strconv.Itoa(length) + ")"
+	return StringField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Length returns the number of characters in the StringField as a NumberField
+// i.e. 'CHAR_LENGTH(field)'. MySQL's LENGTH counts bytes instead of
+// characters.
+func (f StringField) Length() NumberField {
+	format := "CHAR_LENGTH(?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Replace returns a new StringField with every occurrence of from replaced by
+// to i.e. 'REPLACE(field, from, to)'.
+func (f StringField) Replace(from, to string) StringField {
+	format := "REPLACE(?, ?, ?)"
+	return StringField{
+		format: &format,
+		values: []interface{}{f, from, to},
+	}
+}
+
+// Left returns a new StringField of the first n characters i.e. 'LEFT(field,
+// n)'.
+func (f StringField) Left(n int) StringField {
+	format := "LEFT(?, " + strconv.Itoa(n) + ")"
+	return StringField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Right returns a new StringField of the last n characters i.e. 'RIGHT(field,
+// n)'.
+func (f StringField) Right(n int) StringField {
+	format := "RIGHT(?, " + strconv.Itoa(n) + ")"
+	return StringField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// RegexpMatch returns an 'A REGEXP B' Predicate, which is true if the
+// StringField matches the regular expression. It is case sensitive only if
+// the StringField has a case sensitive collation.
+func (f StringField) RegexpMatch(pattern string) Predicate {
+	return CustomPredicate{
+		Format: "? REGEXP ?",
+		Values: []interface{}{f, pattern},
+	}
+}
+
+// RegexpIMatch returns a 'REGEXP_LIKE(A, B, 'i')' Predicate, which is true if
+// the StringField matches the regular expression. It is always case
+// insensitive.
+func (f StringField) RegexpIMatch(pattern string) Predicate {
+	return CustomPredicate{
+		Format: "REGEXP_LIKE(?, ?, 'i')",
+		Values: []interface{}{f, pattern},
+	}
+}
+
+// StartsWith returns an 'A LIKE B' Predicate, which is true if the StringField
+// starts with the prefix. Any '%', '_' or '\' in the prefix are escaped so
+// that they are matched literally.
+func (f StringField) StartsWith(prefix string) Predicate {
+	return CustomPredicate{
+		Format: "? LIKE ?",
+		Values: []interface{}{f, escapeLike(prefix) + "%"},
+	}
+}
+
+// Collate returns a new StringField that uses the collation for comparing and
+// sorting i.e. 'field COLLATE utf8mb4_bin'.
+func (f StringField) Collate(name string) StringField {
+	operand := "?"
+	if f.format != nil {
+		operand = "(?)"
+	}
+	if !isIdentifier(name) {
+		name = "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	}
+	format := operand + " COLLATE " + name
+	return StringField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Coalesce returns a new StringField that is the first of the StringField and
+// the values that is not NULL i.e. 'COALESCE(field, value1, value2)'.
+func (f StringField) Coalesce(values ...interface{}) StringField {
+	format := "COALESCE(?" + strings.Repeat(", ?", len(values)) + ")"
+	return StringField{
+		format: &format,
+		values: append([]interface{}{f}, values...),
+	}
+}
+
+// NullIf returns a new StringField that is NULL if the StringField is equal to
+// the value, or else the StringField i.e. 'NULLIF(field, value)'.
+func (f StringField) NullIf(value interface{}) StringField {
+	format := "NULLIF(?, ?)"
+	return StringField{
+		format: &format,
+		values: []interface{}{f, value},
+	}
+}
+
+// escapeLike escapes the LIKE wildcards in s with a backslash.
+func escapeLike(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "%", `\%`)
+	s = strings.ReplaceAll(s, "_", `\_`)
+	return s
+}
+
 // String returns the string representation of the StringField.
 func (f StringField) String() string {
 	buf := &strings.Builder{}
@@ -1,22 +1,33 @@
 package sq
 
 import (
+	"strconv"
 	"strings"
 	"time"
 )
 
-// TimeField either represents a time column or a literal time.Time value.
+// TimeField either represents a time column, a time expression or a literal
+// time.Time value.
 type TimeField struct {
 	// TimeField will be one of the following:
 
-	// 1) Literal time.Time value
+	// 1) Time expression
+	// Examples of time expressions:
+	// | query                                  | args |
+	// |----------------------------------------|------|
+	// | users.created_at + INTERVAL 30 DAY     |      |
+	// | CONVERT_TZ(events.start_at, ?, ?)      | ...  |
+	format *string
+	values []interface{}
+
+	// 2) Literal time.Time value
 	// Examples of literal string values:
 	// | query | args       |
 	// |-------|------------|
 	// | ?     | time.Now() |
 	value *time.Time
 
-	// 2) Time column
+	// 3) Time column
 	// Examples of time columns:
 	// | query            | args |
 	// |------------------|------|
@@ -33,12 +44,15 @@ type TimeField struct {
 // in the TimeField internal struct comments.
 func (f TimeField) AppendSQLExclude(buf *strings.Builder, args *[]interface{}, params map[string]int, excludedTableQualifiers []string) {
 	switch {
+	case f.format != nil:
+		// 1) Time expression
+		expandValues(buf, args, excludedTableQualifiers, *f.format, f.values)
 	case f.value != nil:
-		// 1) Literal time.Time value
+		// 2) Literal time.Time value
 		buf.WriteString("?")
 		*args = append(*args, *f.value)
 	default:
-		// 2) Time column
+		// 3) Time column
 		tableQualifier := f.table.GetAlias()
 		if tableQualifier == "" {
 			tableQualifier = f.table.GetName()
@@ -131,6 +145,13 @@ func (f TimeField) Desc() TimeField {
 	return f
 }
 
+// ordering implements the orderedField interface.
+func (f TimeField) ordering() (field Field, descending *bool) {
+	descending = f.descending
+	f.descending = nil
+	return f, descending
+}
+
 // IsNull returns an 'X IS NULL' Predicate.
 func (f TimeField) IsNull() Predicate {
 	return CustomPredicate{
@@ -278,6 +299,183 @@ func (f TimeField) NotBetweenTime(start, end time.Time) Predicate {
 	}
 }
 
+// Interval is a MySQL temporal interval made up of calendar units and a
+// time.Duration. It is added to or subtracted from a TimeField one unit at a
+// time i.e. 'field + INTERVAL 1 MONTH + INTERVAL 3600 SECOND'.
+type Interval struct {
+	Years    int
+	Months   int
+	Days     int
+	Duration time.Duration
+}
+
+// AddInterval returns a new TimeField that is the TimeField plus the Interval
+// i.e. 'field + INTERVAL 1 DAY'.
+//
+//	u.CREATED_AT.AddInterval(Interval{Days: 30})
+//	u.CREATED_AT.AddInterval(Interval{Duration: 90 * time.Minute})
+func (f TimeField) AddInterval(interval Interval) TimeField {
+	format := "?" + intervalFormat("+", interval)
+	return TimeField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// SubInterval returns a new TimeField that is the TimeField minus the Interval
+// i.e. 'field - INTERVAL 1 DAY'.
+func (f TimeField) SubInterval(interval Interval) TimeField {
+	format := "?" + intervalFormat("-", interval)
+	return TimeField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// intervalFormat returns the ' + INTERVAL n UNIT' terms of the Interval for the
+// operator. Units that are zero are left out.
+func intervalFormat(operator string, interval Interval) string {
+	buf := &strings.Builder{}
+	term := func(n int64, unit string) {
+		buf.WriteString(" " + operator + " INTERVAL ")
+		buf.WriteString(strconv.FormatInt(n, 10))
+		buf.WriteString(" " + unit)
+	}
+	if interval.Years != 0 {
+		term(int64(interval.Years), "YEAR")
+	}
+	if interval.Months != 0 {
+		term(int64(interval.Months), "MONTH")
+	}
+	if interval.Days != 0 {
+		term(int64(interval.Days), "DAY")
+	}
+	switch {
+	case interval.Duration%time.Second != 0:
+		term(int64(interval.Duration/time.Microsecond), "MICROSECOND")
+	case interval.Duration != 0 || buf.Len() == 0:
+		term(int64(interval.Duration/time.Second), "SECOND")
+	}
+	return buf.String()
+}
+
+// DateTrunc returns a new TimeField truncated to the precision of the unit,
+// which is one of 'year', 'quarter', 'month', 'week', 'day', 'hour', 'minute'
+// or 'second'. Weeks start on Monday. MySQL has no date_trunc, so it is
+// rendered with DATE_FORMAT e.g. 'CAST(DATE_FORMAT(field, '%Y-%m-01') AS
+// DATETIME)'.
+func (f TimeField) DateTrunc(unit string) TimeField {
+	var format string
+	values := []interface{}{f}
+	switch strings.ToLower(unit) {
+	case "year":
+		format = "CAST(DATE_FORMAT(?, '%Y-01-01') AS DATETIME)"
+	case "quarter":
+		format = "CAST(MAKEDATE(YEAR(?), 1) + INTERVAL (QUARTER(?) - 1) QUARTER AS DATETIME)"
+		values = append(values, f)
+	case "month":
+		format = "CAST(DATE_FORMAT(?, '%Y-%m-01') AS DATETIME)"
+	case "week":
+		format = "CAST(DATE(?) - INTERVAL WEEKDAY(?) DAY AS DATETIME)"
+		values = append(values, f)
+	case "day":
+		format = "CAST(DATE(?) AS DATETIME)"
+	case "hour":
+		format = "CAST(DATE_FORMAT(?, '%Y-%m-%d %H:00:00') AS DATETIME)"
+	case "minute":
+		format = "CAST(DATE_FORMAT(?, '%Y-%m-%d %H:%i:00') AS DATETIME)"
+	case "second":
+		format = "CAST(DATE_FORMAT(?, '%Y-%m-%d %H:%i:%s') AS DATETIME)"
+	default:
+		format = "(unsupported unit: only year/quarter/month/week/day/hour/minute/second are supported)"
+		values = nil
+	}
+	return TimeField{
+		format: &format,
+		values: values,
+	}
+}
+
+// Extract returns a part of the TimeField e.g. 'year', 'month', 'day',
+// 'hour', 'year_month' as a NumberField i.e. 'EXTRACT(DAY FROM field)'.
+func (f TimeField) Extract(unit string) NumberField {
+	format := "EXTRACT(" + strings.ToUpper(unit) + " FROM ?)"
+	if !isIdentifier(unit) {
+		format = "(unsupported unit: the unit must only contain letters, digits and underscores)"
+	}
+	return NumberField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// AtTimeZone converts the TimeField from the session time zone to the time
+// zone i.e. 'CONVERT_TZ(field, @@session.time_zone, 'Asia/Singapore')'. Named
+// time zones only work if the MySQL time zone tables have been loaded,
+// otherwise use an offset like '+08:00'.
+func (f TimeField) AtTimeZone(zone string) TimeField {
+	format := "CONVERT_TZ(?, @@session.time_zone, ?)"
+	return TimeField{
+		format: &format,
+		values: []interface{}{f, zone},
+	}
+}
+
+// DateDiff returns the number of days from the other TimeField to the
+// TimeField, ignoring the time of day i.e. 'DATEDIFF(field, other)'.
+func (f TimeField) DateDiff(other TimeField) NumberField {
+	format := "DATEDIFF(?, ?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{f, other},
+	}
+}
+
+// TimestampDiff returns the number of whole units e.g. 'year', 'month', 'day',
+// 'second' from the other TimeField to the TimeField i.e. 'TIMESTAMPDIFF(DAY,
+// other, field)'. It is the MySQL equivalent of Postgres' age.
+func (f TimeField) TimestampDiff(unit string, other TimeField) NumberField {
+	format := "TIMESTAMPDIFF(" + strings.ToUpper(unit) + ", ?, ?)"
+	if !isIdentifier(unit) {
+		format = "(unsupported unit: the unit must only contain letters, digits and underscores)"
+	}
+	return NumberField{
+		format: &format,
+		values: []interface{}{other, f},
+	}
+}
+
+// Now returns the time that the statement started executing as a TimeField
+// i.e. 'NOW()'.
+func Now() TimeField {
+	format := "NOW()"
+	return TimeField{
+		format: &format,
+	}
+}
+
+// CurrentDate returns the current date as a TimeField i.e. 'CURRENT_DATE'.
+func CurrentDate() TimeField {
+	format := "CURRENT_DATE"
+	return TimeField{
+		format: &format,
+	}
+}
+
+// isIdentifier reports whether s only consists of letters, digits and
+// underscores.
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if !(c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')) {
+			return false
+		}
+	}
+	return true
+}
+
 // String returns the string representation of the TimeField.
 func (f TimeField) String() string {
 	buf := &strings.Builder{}
@@ -305,3 +305,72 @@ func TestTimeField_Predicates(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeField_Functions(t *testing.T) {
+	type TT struct {
+		description string
+		f           Field
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	tbl := &TableInfo{Schema: "devlab", Name: "users"}
+	createdAt := NewTimeField("created_at", tbl)
+	updatedAt := NewTimeField("updated_at", tbl)
+	tests := []TT{
+		{"AddInterval days", createdAt.AddInterval(Interval{Days: 30}), "users.created_at + INTERVAL 30 DAY", nil},
+		{
+			"AddInterval mixed",
+			createdAt.AddInterval(Interval{Years: 1, Months: 2, Duration: 90 * time.Minute}),
+			"users.created_at + INTERVAL 1 YEAR + INTERVAL 2 MONTH + INTERVAL 5400 SECOND",
+			nil,
+		},
+		{"SubInterval microseconds", Now().SubInterval(Interval{Duration: 1500 * time.Millisecond}), "NOW() - INTERVAL 1500000 MICROSECOND", nil},
+		{"SubInterval zero", CurrentDate().SubInterval(Interval{}), "CURRENT_DATE - INTERVAL 0 SECOND", nil},
+		{"DateTrunc month", createdAt.DateTrunc("month"), "CAST(DATE_FORMAT(users.created_at, '%Y-%m-01') AS DATETIME)", nil},
+		{"DateTrunc day", createdAt.DateTrunc("DAY").Desc(), "CAST(DATE(users.created_at) AS DATETIME) DESC", nil},
+		{
+			"DateTrunc week",
+			createdAt.DateTrunc("week"),
+			"CAST(DATE(users.created_at) - INTERVAL WEEKDAY(users.created_at) DAY AS DATETIME)",
+			nil,
+		},
+		{
+			"DateTrunc quarter",
+			createdAt.DateTrunc("quarter"),
+			"CAST(MAKEDATE(YEAR(users.created_at), 1) + INTERVAL (QUARTER(users.created_at) - 1) QUARTER AS DATETIME)",
+			nil,
+		},
+		{
+			"DateTrunc unsupported",
+			createdAt.DateTrunc("decade"),
+			"(unsupported unit: only year/quarter/month/week/day/hour/minute/second are supported)",
+			nil,
+		},
+		{"Extract", createdAt.Extract("year_month"), "EXTRACT(YEAR_MONTH FROM users.created_at)", nil},
+		{
+			"AtTimeZone",
+			createdAt.AtTimeZone("+08:00"),
+			"CONVERT_TZ(users.created_at, @@session.time_zone, ?)",
+			[]interface{}{"+08:00"},
+		},
+		{"DateDiff", updatedAt.DateDiff(createdAt), "DATEDIFF(users.updated_at, users.created_at)", nil},
+		{
+			"TimestampDiff",
+			updatedAt.TimestampDiff("month", createdAt),
+			"TIMESTAMPDIFF(MONTH, users.created_at, users.updated_at)",
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.f.AppendSQLExclude(buf, &args, nil, nil)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
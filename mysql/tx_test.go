@@ -0,0 +1,160 @@
+package sq
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"github.com/matryer/is"
+)
+
+// fakeTxConn is a database/sql driver (and its only connection) that records
+// the queries executed on it, so that Tx can be tested with a real *sql.Tx.
+type fakeTxConn struct {
+	queries []string
+}
+
+func (conn *fakeTxConn) Open(name string) (driver.Conn, error) { return conn, nil }
+
+func (conn *fakeTxConn) Connect(ctx context.Context) (driver.Conn, error) { return conn, nil }
+
+func (conn *fakeTxConn) Driver() driver.Driver { return conn }
+
+func (conn *fakeTxConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("fakeTxConn does not support prepared statements")
+}
+
+func (conn *fakeTxConn) Close() error { return nil }
+
+func (conn *fakeTxConn) Begin() (driver.Tx, error) { return conn, nil }
+
+func (conn *fakeTxConn) Commit() error { return nil }
+
+func (conn *fakeTxConn) Rollback() error { return nil }
+
+func (conn *fakeTxConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	conn.queries = append(conn.queries, query)
+	return driver.RowsAffected(0), nil
+}
+
+func TestTx_Savepoint(t *testing.T) {
+	is := is.New(t)
+	conn := &fakeTxConn{}
+	db, err := sql.OpenDB(conn).Begin()
+	is.NoErr(err)
+	defer db.Rollback()
+	savepointNames := func() []string {
+		var names []string
+		for _, query := range conn.queries {
+			names = append(names, query[strings.LastIndex(query, " ")+1:])
+		}
+		return names
+	}
+
+	// Release
+	err = Tx(nil, db, nil, func(tx DB) error {
+		is.Equal(db, tx)
+		return nil
+	})
+	is.NoErr(err)
+	is.Equal(2, len(conn.queries))
+	is.True(strings.HasPrefix(conn.queries[0], "SAVEPOINT sq_savepoint_"))
+	is.True(strings.HasPrefix(conn.queries[1], "RELEASE SAVEPOINT sq_savepoint_"))
+	names := savepointNames()
+	is.Equal(names[0], names[1])
+
+	// Rollback
+	conn.queries = conn.queries[:0]
+	wantErr := errors.New("rollback")
+	err = Tx(nil, db, nil, func(tx DB) error {
+		return wantErr
+	})
+	is.Equal(wantErr, err)
+	is.True(strings.HasPrefix(conn.queries[1], "ROLLBACK TO SAVEPOINT sq_savepoint_"))
+
+	// Nested savepoints have unique names
+	conn.queries = conn.queries[:0]
+	err = Tx(nil, db, nil, func(tx DB) error {
+		return Tx(nil, tx, nil, func(tx DB) error {
+			return nil
+		})
+	})
+	is.NoErr(err)
+	names = savepointNames()
+	is.Equal(4, len(names))
+	is.True(names[0] != names[1])
+	is.Equal(names[1], names[2])
+	is.Equal(names[0], names[3])
+
+	// Panic
+	conn.queries = conn.queries[:0]
+	func() {
+		defer func() {
+			is.Equal("panic", recover())
+		}()
+		_ = Tx(nil, db, nil, func(tx DB) error {
+			panic("panic")
+		})
+	}()
+	is.True(strings.HasPrefix(conn.queries[1], "ROLLBACK TO SAVEPOINT sq_savepoint_"))
+
+	// A DB that is neither a transaction nor can begin one is an error, even if
+	// it wraps a transaction
+	called := false
+	err = Tx(nil, struct{ DB }{db}, nil, func(tx DB) error {
+		called = true
+		return nil
+	})
+	is.True(err != nil)
+	is.True(!called)
+}
+
+func TestIsRetryableTxError(t *testing.T) {
+	is := is.New(t)
+	is.True(isRetryableTxError(&mysqldriver.MySQLError{Number: 1213}))
+	is.True(isRetryableTxError(fmt.Errorf("wrapped: %w", &mysqldriver.MySQLError{Number: 1205})))
+	is.True(!isRetryableTxError(&mysqldriver.MySQLError{Number: 1062}))
+	is.True(!isRetryableTxError(errors.New("1213")))
+	is.True(!isRetryableTxError(nil))
+}
+
+func TestTx(t *testing.T) {
+	if testing.Short() {
+		return
+	}
+	is := is.New(t)
+	db, err := sql.Open("txdb", "Tx")
+	is.NoErr(err)
+	defer db.Close()
+	u := USERS()
+
+	err = Tx(context.Background(), db, nil, func(tx DB) error {
+		_, err := Update(u).Set(u.DISPLAYNAME.SetString("outer")).Where(u.USER_ID.EqInt(1)).Exec(tx, 0)
+		if err != nil {
+			return err
+		}
+		// the nested update is rolled back to its savepoint
+		_ = Tx(context.Background(), tx, nil, func(tx DB) error {
+			_, err := Update(u).Set(u.DISPLAYNAME.SetString("inner")).Where(u.USER_ID.EqInt(1)).Exec(tx, 0)
+			if err != nil {
+				return err
+			}
+			return errors.New("rollback")
+		})
+		var displayname string
+		err = From(u).Where(u.USER_ID.EqInt(1)).SelectRowx(func(row *Row) {
+			displayname = row.String(u.DISPLAYNAME)
+		}).Fetch(tx)
+		if err != nil {
+			return err
+		}
+		is.Equal("outer", displayname)
+		return nil
+	})
+	is.NoErr(err)
+}
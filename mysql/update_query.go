@@ -35,6 +35,7 @@ type UpdateQuery struct {
 	// Logging
 	Log     Logger
 	LogFlag LogFlag
+	LogFunc LogFunc
 	logSkip int
 }
 
@@ -48,6 +49,15 @@ func (q UpdateQuery) ToSQL() (query string, args []interface{}) {
 	q.logSkip += 1
 	buf := &strings.Builder{}
 	q.AppendSQL(buf, &args, nil)
+	if q.LogFunc != nil {
+		q.LogFunc(LogInfo{
+			LogFlag: q.LogFlag,
+			LogSkip: q.logSkip + 2,
+			Query:   buf.String(),
+			Args:    args,
+			Action:  LogActionToSQL,
+		})
+	}
 	return buf.String(), args
 }
 
@@ -84,16 +94,16 @@ func (q UpdateQuery) AppendSQL(buf *strings.Builder, args *[]interface{}, params
 			buf.WriteString(alias)
 		}
 	}
-	// SET
-	if len(q.Assignments) > 0 {
-		buf.WriteString(" SET ")
-		q.Assignments.AppendSQLExclude(buf, args, nil, nil)
-	}
 	// JOIN
 	if len(q.JoinTables) > 0 {
 		buf.WriteString(" ")
 		q.JoinTables.AppendSQL(buf, args, nil)
 	}
+	// SET
+	if len(q.Assignments) > 0 {
+		buf.WriteString(" SET ")
+		q.Assignments.AppendSQLExclude(buf, args, nil, nil)
+	}
 	// WHERE
 	if len(q.WherePredicate.Predicates) > 0 {
 		buf.WriteString(" WHERE ")
@@ -150,6 +160,13 @@ func Update(table BaseTable) UpdateQuery {
 	}
 }
 
+// Updatex creates a new UpdateQuery that updates the JoinedTables' FROM table
+// joined with its JoinTables i.e. 'UPDATE t1 JOIN t2 ON ... SET ...'. MySQL can
+// only update base tables, so the FROM table must be a BaseTable.
+func Updatex(joined JoinedTablesGetter) UpdateQuery {
+	return Update(nil).Updatex(joined)
+}
+
 // With appends a list of CTEs into the UpdateQuery.
 func (q UpdateQuery) With(ctes ...CTE) UpdateQuery {
 	q.CTEs = append(q.CTEs, ctes...)
@@ -162,6 +179,15 @@ func (q UpdateQuery) Update(table BaseTable) UpdateQuery {
 	return q
 }
 
+// Updatex sets the table to be updated to the JoinedTables' FROM table,
+// followed by its JoinTables. The FROM table must be a BaseTable.
+func (q UpdateQuery) Updatex(joined JoinedTablesGetter) UpdateQuery {
+	jt := joined.GetJoinedTables()
+	q.UpdateTable, _ = jt.FromTable.(BaseTable)
+	q.JoinTables = jt.prependJoins(q.JoinTables)
+	return q
+}
+
 // Set appends the assignments to SET clause of the UpdateQuery.
 func (q UpdateQuery) Set(assignments ...Assignment) UpdateQuery {
 	q.Assignments = append(q.Assignments, assignments...)
@@ -268,14 +294,31 @@ func (q UpdateQuery) Exec(db DB, flag ExecFlag) (rowsAffected int64, err error)
 // ExecContext will execute the UpdateQuery with the given DB and context. It will
 // only compute the rowsAffected if the ErowsAffected Execflag is passed to it.
 func (q UpdateQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (rowsAffected int64, err error) {
+	logBuf := &strings.Builder{}
+	start := time.Now()
+	var logQuery string
+	var logArgs []interface{}
+	if q.LogFunc != nil {
+		defer func() {
+			q.LogFunc(LogInfo{
+				LogFlag:      q.LogFlag,
+				LogSkip:      q.logSkip + 3,
+				Query:        logQuery,
+				Args:         logArgs,
+				Action:       LogActionExec,
+				TimeTaken:    time.Since(start),
+				Err:          err,
+				ExecFlag:     flag,
+				RowsAffected: rowsAffected,
+			})
+		}()
+	}
 	if db == nil {
 		if q.DB == nil {
 			return rowsAffected, errors.New("DB cannot be nil")
 		}
 		db = q.DB
 	}
-	logBuf := &strings.Builder{}
-	start := time.Now()
 	defer func() {
 		if r := recover(); r != nil {
 			switch v := r.(type) {
@@ -311,6 +354,9 @@ func (q UpdateQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (row
 	var tmpargs []interface{}
 	q.logSkip += 1
 	q.AppendSQL(tmpbuf, &tmpargs, nil)
+	if q.LogFunc != nil {
+		logQuery, logArgs = tmpbuf.String(), tmpargs
+	}
 	if ctx == nil {
 		res, err = db.Exec(tmpbuf.String(), tmpargs...)
 	} else {
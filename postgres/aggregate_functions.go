@@ -1,5 +1,7 @@
 package sq
 
+import "strconv"
+
 // Count represents the COUNT(*) aggregate function.
 func Count() NumberField {
 	format := "COUNT(*)"
@@ -88,3 +90,166 @@ func MaxOver(field interface{}, window Window) NumberField {
 		values: []interface{}{field, window},
 	}
 }
+
+// CountDistinct represents the COUNT(DISTINCT) aggregate function, which
+// counts the distinct non-NULL values of the field.
+func CountDistinct(field interface{}) NumberField {
+	format := "COUNT(DISTINCT ?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{field},
+	}
+}
+
+// Distinct marks the argument of an aggregate function so that only its
+// distinct values are aggregated e.g. Sum(Distinct(field)) is 'SUM(DISTINCT
+// field)'.
+func Distinct(field interface{}) CustomField {
+	return CustomField{
+		Format: "DISTINCT ?",
+		Values: []interface{}{field},
+	}
+}
+
+// StringAgg represents the STRING_AGG() aggregate function, which concatenates
+// the values separated by the delimiter. The values are concatenated in the
+// order of the orderBy fields if any i.e. 'STRING_AGG(field, delimiter ORDER
+// BY orderBy)'.
+func StringAgg(field interface{}, delimiter string, orderBy ...Field) StringField {
+	orderByFormat, orderByValues := aggregateOrderBy(orderBy)
+	format := "STRING_AGG(?, ?" + orderByFormat + ")"
+	return StringField{
+		format: &format,
+		values: append([]interface{}{field, delimiter}, orderByValues...),
+	}
+}
+
+// ArrayAgg represents the ARRAY_AGG() aggregate function, which collects the
+// values into an array in the order of the orderBy fields if any i.e.
+// 'ARRAY_AGG(field ORDER BY orderBy)'.
+func ArrayAgg(field interface{}, orderBy ...Field) ArrayField {
+	orderByFormat, orderByValues := aggregateOrderBy(orderBy)
+	format := "ARRAY_AGG(?" + orderByFormat + ")"
+	return ArrayField{
+		format: &format,
+		values: append([]interface{}{field}, orderByValues...),
+	}
+}
+
+// JSONAgg represents the JSON_AGG() aggregate function, which collects the
+// values into a JSON array in the order of the orderBy fields if any i.e.
+// 'JSON_AGG(field ORDER BY orderBy)'.
+func JSONAgg(field interface{}, orderBy ...Field) JSONField {
+	orderByFormat, orderByValues := aggregateOrderBy(orderBy)
+	format := "JSON_AGG(?" + orderByFormat + ")"
+	return JSONField{
+		format: &format,
+		values: append([]interface{}{field}, orderByValues...),
+	}
+}
+
+// JSONBAgg represents the JSONB_AGG() aggregate function, which collects the
+// values into a JSONB array in the order of the orderBy fields if any i.e.
+// 'JSONB_AGG(field ORDER BY orderBy)'.
+func JSONBAgg(field interface{}, orderBy ...Field) JSONField {
+	orderByFormat, orderByValues := aggregateOrderBy(orderBy)
+	format := "JSONB_AGG(?" + orderByFormat + ")"
+	return JSONField{
+		format: &format,
+		values: append([]interface{}{field}, orderByValues...),
+	}
+}
+
+// JSONObjectAgg represents the JSON_OBJECT_AGG() aggregate function, which
+// collects the key value pairs into a JSON object.
+func JSONObjectAgg(key, value interface{}) JSONField {
+	format := "JSON_OBJECT_AGG(?, ?)"
+	return JSONField{
+		format: &format,
+		values: []interface{}{key, value},
+	}
+}
+
+// JSONBObjectAgg represents the JSONB_OBJECT_AGG() aggregate function, which
+// collects the key value pairs into a JSONB object.
+func JSONBObjectAgg(key, value interface{}) JSONField {
+	format := "JSONB_OBJECT_AGG(?, ?)"
+	return JSONField{
+		format: &format,
+		values: []interface{}{key, value},
+	}
+}
+
+// BoolAnd represents the BOOL_AND() aggregate function, which is true if all
+// the values are true.
+func BoolAnd(predicate interface{}) BooleanField {
+	format := "BOOL_AND(?)"
+	return BooleanField{
+		format: &format,
+		values: []interface{}{predicate},
+	}
+}
+
+// BoolOr represents the BOOL_OR() aggregate function, which is true if any of
+// the values are true.
+func BoolOr(predicate interface{}) BooleanField {
+	format := "BOOL_OR(?)"
+	return BooleanField{
+		format: &format,
+		values: []interface{}{predicate},
+	}
+}
+
+// PercentileCont represents the PERCENTILE_CONT() ordered-set aggregate
+// function, which returns the value at the fraction (between 0 and 1) of the
+// orderBy values, interpolating between adjacent values if needed i.e.
+// 'PERCENTILE_CONT(fraction) WITHIN GROUP (ORDER BY orderBy)'.
+func PercentileCont(fraction float64, orderBy Field) NumberField {
+	format := "PERCENTILE_CONT(" + strconv.FormatFloat(fraction, 'f', -1, 64) + ") WITHIN GROUP (ORDER BY ?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{orderBy},
+	}
+}
+
+// PercentileDisc represents the PERCENTILE_DISC() ordered-set aggregate
+// function, which returns the first of the orderBy values whose position is
+// at or after the fraction (between 0 and 1) i.e. 'PERCENTILE_DISC(fraction)
+// WITHIN GROUP (ORDER BY orderBy)'.
+func PercentileDisc(fraction float64, orderBy Field) NumberField {
+	format := "PERCENTILE_DISC(" + strconv.FormatFloat(fraction, 'f', -1, 64) + ") WITHIN GROUP (ORDER BY ?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{orderBy},
+	}
+}
+
+// Mode represents the MODE() ordered-set aggregate function, which returns the
+// most frequent of the orderBy values i.e. 'MODE() WITHIN GROUP (ORDER BY
+// orderBy)'. It returns a CustomField because the result has the same type as
+// the orderBy field.
+func Mode(orderBy Field) CustomField {
+	return CustomField{
+		Format: "MODE() WITHIN GROUP (ORDER BY ?)",
+		Values: []interface{}{orderBy},
+	}
+}
+
+// aggregateOrderBy returns the format and values of the ORDER BY clause inside
+// an aggregate function, or nothing if there are no orderBy fields.
+func aggregateOrderBy(orderBy []Field) (format string, values []interface{}) {
+	if len(orderBy) == 0 {
+		return "", nil
+	}
+	return " ORDER BY ?", []interface{}{Fields(orderBy)}
+}
+
+// toplevelPredicate removes the enclosing brackets of a VariadicPredicate that
+// is the only predicate inside a FILTER (WHERE ...) clause.
+func toplevelPredicate(predicate Predicate) Predicate {
+	if p, ok := predicate.(VariadicPredicate); ok {
+		p.toplevel = true
+		return p
+	}
+	return predicate
+}
@@ -87,6 +87,118 @@ func TestAggregateFunctions(t *testing.T) {
 			"MAX(ur.user_role_id) OVER (PARTITION BY ur.user_id)",
 			nil,
 		},
+		{
+			"CountDistinct",
+			CountDistinct(ur.USER_ID),
+			nil,
+			"COUNT(DISTINCT ur.user_id)",
+			nil,
+		},
+		{
+			"Sum Distinct",
+			Sum(Distinct(ur.USER_ID)),
+			nil,
+			"SUM(DISTINCT ur.user_id)",
+			nil,
+		},
+		{
+			"Count Filter",
+			Count().Filter(ur.COHORT.EqString("2020")),
+			nil,
+			"COUNT(*) FILTER (WHERE ur.cohort = ?)",
+			[]interface{}{"2020"},
+		},
+		{
+			"Sum Filter variadic predicate",
+			Sum(ur.USER_ID).Filter(And(ur.COHORT.EqString("2020"), ur.ROLE.IsNotNull())),
+			nil,
+			"SUM(ur.user_id) FILTER (WHERE ur.cohort = ? AND ur.role IS NOT NULL)",
+			[]interface{}{"2020"},
+		},
+		{
+			"StringAgg",
+			StringAgg(ur.ROLE, ", "),
+			nil,
+			"STRING_AGG(ur.role, ?)",
+			[]interface{}{", "},
+		},
+		{
+			"StringAgg ordered",
+			StringAgg(Distinct(ur.ROLE), ",", ur.ROLE.Desc()).Filter(ur.USER_ID.GtInt(1)),
+			nil,
+			"STRING_AGG(DISTINCT ur.role, ? ORDER BY ur.role DESC) FILTER (WHERE ur.user_id > ?)",
+			[]interface{}{",", 1},
+		},
+		{
+			"ArrayAgg",
+			ArrayAgg(ur.USER_ID, ur.CREATED_AT, ur.USER_ROLE_ID.Desc()),
+			[]string{"ur"},
+			"ARRAY_AGG(user_id ORDER BY created_at, user_role_id DESC)",
+			nil,
+		},
+		{
+			"JSONAgg",
+			JSONAgg(ur.ROLE),
+			nil,
+			"JSON_AGG(ur.role)",
+			nil,
+		},
+		{
+			"JSONBAgg",
+			JSONBAgg(ur.ROLE, ur.ROLE).Filter(ur.ROLE.IsNotNull()),
+			nil,
+			"JSONB_AGG(ur.role ORDER BY ur.role) FILTER (WHERE ur.role IS NOT NULL)",
+			nil,
+		},
+		{
+			"JSONObjectAgg",
+			JSONObjectAgg(ur.ROLE, ur.USER_ID),
+			nil,
+			"JSON_OBJECT_AGG(ur.role, ur.user_id)",
+			nil,
+		},
+		{
+			"JSONBObjectAgg",
+			JSONBObjectAgg(ur.ROLE, ur.USER_ID),
+			nil,
+			"JSONB_OBJECT_AGG(ur.role, ur.user_id)",
+			nil,
+		},
+		{
+			"BoolAnd",
+			BoolAnd(ur.ROLE.IsNotNull()),
+			nil,
+			"BOOL_AND(ur.role IS NOT NULL)",
+			nil,
+		},
+		{
+			"BoolOr Filter",
+			BoolOr(ur.ROLE.EqString("admin")).Filter(ur.USER_ID.GtInt(1)),
+			nil,
+			"BOOL_OR(ur.role = ?) FILTER (WHERE ur.user_id > ?)",
+			[]interface{}{"admin", 1},
+		},
+		{
+			"PercentileCont",
+			PercentileCont(0.5, ur.USER_ID),
+			nil,
+			"PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY ur.user_id)",
+			nil,
+		},
+		{
+			"PercentileDisc",
+			PercentileDisc(0.95, ur.USER_ID.Desc()),
+			nil,
+			"PERCENTILE_DISC(0.95) WITHIN GROUP (ORDER BY ur.user_id DESC)",
+			nil,
+		},
+		{
+			"Mode",
+			Mode(ur.ROLE),
+			nil,
+			"MODE() WITHIN GROUP (ORDER BY ur.role)",
+			nil,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
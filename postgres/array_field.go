@@ -3,14 +3,25 @@ package sq
 import (
 	"database/sql/driver"
 	"fmt"
+	"strconv"
 	"strings"
 )
 
-// ArrayField either represents an ARRAY column, or a literal slice value.
+// ArrayField either represents an ARRAY column, an array expression or a
+// literal slice value.
 type ArrayField struct {
 	// ArrayField will be one of the following:
 
-	// 1) Literal slice value (only []bool, []float64, []int64 or []string
+	// 1) Array expression
+	// Examples of array expressions:
+	// | query                        | args  |
+	// |------------------------------|-------|
+	// | array_append(tbl.column, ?)  | apple |
+	// | tbl.column[2:3]              |       |
+	format *string
+	values []interface{}
+
+	// 2) Literal slice value (only []bool, []float64, []int64 or []string
 	// slices are supported.) Nested slices are also not supported even though
 	// both Go and Postgres support nested slices/arrays because I'm not even
 	// sure if it's possible to convert between the two with lib/pq.
@@ -25,8 +36,8 @@ type ArrayField struct {
 	// | ARRAY[?, ?, ?]    | apple, banana, cucumber |
 	value interface{}
 
-	// 2) Array column
-	// Examples of boolean columns:
+	// 3) Array column
+	// Examples of array columns:
 	// | query                 | args |
 	// |-----------------------|------|
 	// | film.special_features |      |
@@ -43,8 +54,11 @@ type ArrayField struct {
 // excludedTableQualifiers list.
 func (f ArrayField) AppendSQLExclude(buf *strings.Builder, args *[]interface{}, params map[string]int, excludedTableQualifiers []string) {
 	switch {
+	case f.format != nil:
+		// 1) Array expression
+		expandValues(buf, args, excludedTableQualifiers, *f.format, f.values)
 	case f.value != nil:
-		// 1) Literal slice value
+		// 2) Literal slice value
 		switch array := f.value.(type) {
 		case []bool:
 			if len(array) == 0 {
@@ -105,7 +119,7 @@ func (f ArrayField) AppendSQLExclude(buf *strings.Builder, args *[]interface{},
 			buf.WriteString(fmt.Sprintf("(unsupported type %#v: only []bool/[]float64/[]int64/[]string/[]int slices are supported.)", f.value))
 		}
 	default:
-		// 2) Array column
+		// 3) Array column
 		tableQualifier := f.table.GetAlias()
 		if tableQualifier == "" {
 			tableQualifier = f.table.GetName()
@@ -220,6 +234,13 @@ func (f ArrayField) NullsLast() ArrayField {
 	return f
 }
 
+// ordering implements the orderedField interface.
+func (f ArrayField) ordering() (field Field, descending, nullsfirst *bool) {
+	descending, nullsfirst = f.descending, f.nullsfirst
+	f.descending, f.nullsfirst = nil, nil
+	return f, descending, nullsfirst
+}
+
 // IsNull returns an 'X IS NULL' Predicate.
 func (f ArrayField) IsNull() Predicate {
 	return CustomPredicate{
@@ -319,6 +340,99 @@ func (f ArrayField) Concat(field ArrayField) Field {
 	}
 }
 
+// operand returns the format that the ArrayField should be written as when it
+// is the operand of a subscript. Anything that is not an array column has to be
+// wrapped in brackets i.e. '(ARRAY[1, 2, 3])[1]'.
+func (f ArrayField) operand() string {
+	if f.format != nil || f.value != nil {
+		return "(?)"
+	}
+	return "?"
+}
+
+// Index returns the element of the ArrayField at the index i.e. 'array[i]'.
+// Postgres arrays are 1-indexed.
+func (f ArrayField) Index(i int) CustomField {
+	return CustomField{
+		Format: f.operand() + "[" + strconv.Itoa(i) + "]",
+		Values: []interface{}{f},
+	}
+}
+
+// Slice returns the slice of the ArrayField between the lower and upper
+// indexes (inclusive) i.e. 'array[lower:upper]'.
+func (f ArrayField) Slice(lower, upper int) ArrayField {
+	format := f.operand() + "[" + strconv.Itoa(lower) + ":" + strconv.Itoa(upper) + "]"
+	return ArrayField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Length returns the length of the requested dimension of the ArrayField i.e.
+// 'array_length(array, dimension)'. Note that the length of an empty array is
+// NULL, use Cardinality if you want 0 instead.
+func (f ArrayField) Length(dimension int) NumberField {
+	format := "array_length(?, " + strconv.Itoa(dimension) + ")"
+	return NumberField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Cardinality returns the total number of elements in the ArrayField across
+// all dimensions i.e. 'cardinality(array)'.
+func (f ArrayField) Cardinality() NumberField {
+	format := "cardinality(?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Append returns the ArrayField with the value added to the end i.e.
+// 'array_append(array, value)'.
+func (f ArrayField) Append(value interface{}) ArrayField {
+	format := "array_append(?, ?)"
+	return ArrayField{
+		format: &format,
+		values: []interface{}{f, value},
+	}
+}
+
+// Remove returns the ArrayField with every element equal to the value removed
+// i.e. 'array_remove(array, value)'.
+func (f ArrayField) Remove(value interface{}) ArrayField {
+	format := "array_remove(?, ?)"
+	return ArrayField{
+		format: &format,
+		values: []interface{}{f, value},
+	}
+}
+
+// SetAppend returns a FieldAssignment that adds the value to the end of the
+// ArrayField i.e. 'field = array_append(field, value)'.
+func (f ArrayField) SetAppend(value interface{}) FieldAssignment {
+	return f.Set(f.Append(value))
+}
+
+// SetRemove returns a FieldAssignment that removes every element equal to the
+// value from the ArrayField i.e. 'field = array_remove(field, value)'.
+func (f ArrayField) SetRemove(value interface{}) FieldAssignment {
+	return f.Set(f.Remove(value))
+}
+
+// Filter returns a new ArrayField that only aggregates the rows which satisfy
+// the predicate i.e. 'field FILTER (WHERE predicate)'. The ArrayField must be
+// an aggregate function e.g. ArrayAgg(field).
+func (f ArrayField) Filter(predicate Predicate) ArrayField {
+	format := "? FILTER (WHERE ?)"
+	return ArrayField{
+		format: &format,
+		values: []interface{}{f, toplevelPredicate(predicate)},
+	}
+}
+
 // String implements the fmt.Stringer interface. It returns the string
 // representation of an ArrayField.
 func (f ArrayField) String() string {
@@ -231,6 +231,20 @@ func TestArrayField_FieldAssignment(t *testing.T) {
 			"users.user_list = ?",
 			[]interface{}{customArray},
 		},
+		{
+			"set to array_append",
+			f.SetAppend("tom"),
+			[]string{"users"},
+			"user_list = array_append(user_list, ?)",
+			[]interface{}{"tom"},
+		},
+		{
+			"set to array_remove",
+			f.SetRemove("tom"),
+			[]string{"users"},
+			"user_list = array_remove(user_list, ?)",
+			[]interface{}{"tom"},
+		},
 		{
 			"set to invalid value",
 			f.Set("scaler"),
@@ -337,6 +351,22 @@ func TestArrayField_Predicates(t *testing.T) {
 			wantArgs := []interface{}{1, 2, 3, 2, 3}
 			return TT{desc, p, nil, wantQuery, wantArgs}
 		}(),
+		func() TT {
+			desc := "EqAny"
+			u := USERS()
+			p := u.USER_ID.EqAny(Array([]int{1, 2, 3}))
+			wantQuery := "users.user_id = ANY(ARRAY[?, ?, ?])"
+			wantArgs := []interface{}{1, 2, 3}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "NeAll"
+			f := NewArrayField("user_list", &TableInfo{Schema: "public", Name: "users"})
+			p := String("tom").NeAll(f)
+			wantQuery := "? <> ALL(users.user_list)"
+			wantArgs := []interface{}{"tom"}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -351,3 +381,35 @@ func TestArrayField_Predicates(t *testing.T) {
 		})
 	}
 }
+
+func TestArrayField_Functions(t *testing.T) {
+	type TT struct {
+		description string
+		f           Field
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	f := NewArrayField("user_list", &TableInfo{Schema: "public", Name: "users"})
+	tests := []TT{
+		{"Index", f.Index(1), "users.user_list[1]", nil},
+		{"Index literal", Array([]int{1, 2}).Index(2), "(ARRAY[?, ?])[2]", []interface{}{1, 2}},
+		{"Slice", f.Slice(2, 3), "users.user_list[2:3]", nil},
+		{"Index of Slice", f.Slice(2, 3).Index(1), "(users.user_list[2:3])[1]", nil},
+		{"Length", f.Length(1), "array_length(users.user_list, 1)", nil},
+		{"Cardinality", f.Cardinality(), "cardinality(users.user_list)", nil},
+		{"Append", f.Append("tom"), "array_append(users.user_list, ?)", []interface{}{"tom"}},
+		{"Remove", f.Remove("tom").Concat(Array([]string{"dick"})), "array_remove(users.user_list, ?) || ARRAY[?]", []interface{}{"tom", "dick"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.f.AppendSQLExclude(buf, &args, nil, nil)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package sq
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+)
+
+// scanArray returns the sql.Scanner used to scan a postgres array into the
+// slice pointer. lib/pq handles one dimensional arrays of most element types,
+// but it cannot scan []time.Time, [][]byte or multi-dimensional slices, so
+// those are scanned by an arrayScanner instead.
+func scanArray(slice interface{}) sql.Scanner {
+	typ := reflect.TypeOf(slice)
+	if typ == nil || typ.Kind() != reflect.Ptr || typ.Elem().Kind() != reflect.Slice {
+		return pq.Array(slice)
+	}
+	elem := typ.Elem().Elem()
+	if elem == timeType || elem.Kind() == reflect.Slice {
+		return arrayScanner{dest: slice}
+	}
+	return pq.Array(slice)
+}
+
+// arrayScanner scans the text representation of a postgres array of any
+// dimension into a pointer to a (nested) slice.
+type arrayScanner struct {
+	dest interface{}
+}
+
+// arrayElement is an element of a parsed postgres array. It is either a nested
+// array, a NULL or a value in its text representation.
+type arrayElement struct {
+	isArray bool
+	isNull  bool
+	elems   []arrayElement
+	text    []byte
+}
+
+// Scan implements the sql.Scanner interface.
+func (a arrayScanner) Scan(src interface{}) error {
+	dv := reflect.ValueOf(a.dest)
+	if dv.Kind() != reflect.Ptr || dv.IsNil() {
+		return fmt.Errorf("destination %T is not a pointer to a slice", a.dest)
+	}
+	var data []byte
+	switch src := src.(type) {
+	case nil:
+		dv.Elem().Set(reflect.Zero(dv.Elem().Type()))
+		return nil
+	case []byte:
+		data = src
+	case string:
+		data = []byte(src)
+	default:
+		return fmt.Errorf("cannot convert %T to %s", src, dv.Elem().Type())
+	}
+	// skip the dimension decoration if there is any i.e. '[0:1]={1,2}'
+	if len(data) > 0 && data[0] == '[' {
+		i := bytes.IndexByte(data, '=')
+		if i < 0 {
+			return fmt.Errorf("invalid array %q", data)
+		}
+		data = data[i+1:]
+	}
+	elem, rest, err := parseArrayElement(data)
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return fmt.Errorf("unexpected %q after the end of the array", rest)
+	}
+	if !elem.isArray {
+		return fmt.Errorf("invalid array %q", data)
+	}
+	return assignArrayElement(elem, dv.Elem())
+}
+
+// parseArrayElement parses one array element from the start of the data and
+// returns the remaining data.
+func parseArrayElement(data []byte) (elem arrayElement, rest []byte, err error) {
+	if len(data) == 0 {
+		return elem, nil, fmt.Errorf("unexpected end of array")
+	}
+	switch data[0] {
+	case '{':
+		elem.isArray = true
+		data = data[1:]
+		if len(data) > 0 && data[0] == '}' {
+			return elem, data[1:], nil
+		}
+		for {
+			var child arrayElement
+			child, data, err = parseArrayElement(data)
+			if err != nil {
+				return elem, nil, err
+			}
+			elem.elems = append(elem.elems, child)
+			if len(data) == 0 {
+				return elem, nil, fmt.Errorf("unexpected end of array")
+			}
+			switch data[0] {
+			case ',':
+				data = data[1:]
+			case '}':
+				return elem, data[1:], nil
+			default:
+				return elem, nil, fmt.Errorf("unexpected %q in array", data[0])
+			}
+		}
+	case '"':
+		elem.text = []byte{}
+		for i := 1; i < len(data); i++ {
+			switch data[i] {
+			case '\\':
+				i++
+				if i < len(data) {
+					elem.text = append(elem.text, data[i])
+				}
+			case '"':
+				return elem, data[i+1:], nil
+			default:
+				elem.text = append(elem.text, data[i])
+			}
+		}
+		return elem, nil, fmt.Errorf("unterminated quoted array element")
+	default:
+		i := bytes.IndexAny(data, ",}")
+		if i < 0 {
+			i = len(data)
+		}
+		elem.text = data[:i]
+		if bytes.EqualFold(elem.text, []byte("NULL")) {
+			elem.isNull, elem.text = true, nil
+		}
+		return elem, data[i:], nil
+	}
+}
+
+// assignArrayElement assigns the array element to the destination value,
+// recursing into nested arrays. Only []byte is treated as a value rather than
+// as a nested array.
+func assignArrayElement(elem arrayElement, dv reflect.Value) error {
+	if dv.Kind() == reflect.Slice && dv.Type().Elem().Kind() != reflect.Uint8 {
+		if elem.isNull {
+			dv.Set(reflect.Zero(dv.Type()))
+			return nil
+		}
+		if !elem.isArray {
+			return fmt.Errorf("cannot scan array element %q into %s", elem.text, dv.Type())
+		}
+		slice := reflect.MakeSlice(dv.Type(), len(elem.elems), len(elem.elems))
+		for i := range elem.elems {
+			if err := assignArrayElement(elem.elems[i], slice.Index(i)); err != nil {
+				return err
+			}
+		}
+		dv.Set(slice)
+		return nil
+	}
+	if elem.isArray {
+		return fmt.Errorf("cannot scan a nested array into %s, the destination has too few dimensions", dv.Type())
+	}
+	if dv.Addr().Type().Implements(scannerType) {
+		scanner := dv.Addr().Interface().(sql.Scanner)
+		if elem.isNull {
+			return scanner.Scan(nil)
+		}
+		return scanner.Scan(elem.text)
+	}
+	if elem.isNull {
+		dv.Set(reflect.Zero(dv.Type()))
+		return nil
+	}
+	text := string(elem.text)
+	if dv.Type() == timeType {
+		t, err := pq.ParseTimestamp(nil, text)
+		if err != nil {
+			return err
+		}
+		dv.Set(reflect.ValueOf(t))
+		return nil
+	}
+	switch dv.Kind() {
+	case reflect.Slice: // []byte
+		b := elem.text
+		if len(text) >= 2 && text[:2] == `\x` {
+			var err error
+			b, err = hex.DecodeString(text[2:])
+			if err != nil {
+				return err
+			}
+		}
+		dv.SetBytes(append([]byte{}, b...))
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		dv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, dv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(text, 10, dv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(text, dv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dv.SetFloat(n)
+	case reflect.String:
+		dv.SetString(text)
+	default:
+		return fmt.Errorf("cannot scan array element %q into %s", text, dv.Type())
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package sq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matryer/is"
+)
+
+func TestScanArray(t *testing.T) {
+	t.Run("[]uuid.UUID", func(t *testing.T) {
+		is := is.New(t)
+		var ids []uuid.UUID
+		err := scanArray(&ids).Scan([]byte("{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11,NULL}"))
+		is.NoErr(err)
+		is.Equal([]uuid.UUID{uuid.MustParse("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"), uuid.Nil}, ids)
+	})
+	t.Run("[]time.Time", func(t *testing.T) {
+		is := is.New(t)
+		var times []time.Time
+		err := scanArray(&times).Scan([]byte(`{"2020-01-02 03:04:05+00","2021-06-07 08:09:10.5+08",NULL,2022-03-04}`))
+		is.NoErr(err)
+		is.Equal(4, len(times))
+		is.True(times[0].Equal(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)))
+		is.True(times[1].Equal(time.Date(2021, 6, 7, 0, 9, 10, 500000000, time.UTC)))
+		is.True(times[2].IsZero())
+		is.Equal("2022-03-04", times[3].Format("2006-01-02"))
+	})
+	t.Run("[][]byte", func(t *testing.T) {
+		is := is.New(t)
+		var blobs [][]byte
+		err := scanArray(&blobs).Scan([]byte(`{"\\x68656c6c6f","\\x",NULL}`))
+		is.NoErr(err)
+		is.Equal([][]byte{[]byte("hello"), {}, nil}, blobs)
+	})
+	t.Run("multi-dimensional", func(t *testing.T) {
+		is := is.New(t)
+		var matrix [][]int64
+		err := scanArray(&matrix).Scan([]byte("{{1,2,3},{4,5,6}}"))
+		is.NoErr(err)
+		is.Equal([][]int64{{1, 2, 3}, {4, 5, 6}}, matrix)
+
+		var words [][]string
+		err = scanArray(&words).Scan([]byte(`[0:1][1:2]={{a,"b c"},{"d \"e\"",NULL}}`))
+		is.NoErr(err)
+		is.Equal([][]string{{"a", "b c"}, {`d "e"`, ""}}, words)
+
+		var cube [][][]bool
+		err = scanArray(&cube).Scan("{{{t,f}},{{f,t}}}")
+		is.NoErr(err)
+		is.Equal([][][]bool{{{true, false}}, {{false, true}}}, cube)
+
+		var empty [][]float64
+		err = scanArray(&empty).Scan([]byte("{}"))
+		is.NoErr(err)
+		is.Equal([][]float64{}, empty)
+
+		err = scanArray(&matrix).Scan(nil)
+		is.NoErr(err)
+		is.Equal([][]int64(nil), matrix)
+	})
+	t.Run("errors", func(t *testing.T) {
+		is := is.New(t)
+		var matrix [][]int64
+		is.True(scanArray(&matrix).Scan([]byte("{1,2}")) != nil)
+		is.True(scanArray(&matrix).Scan([]byte("{{1,2}")) != nil)
+		is.True(scanArray(&matrix).Scan([]byte("{{a}}")) != nil)
+		var times []time.Time
+		is.True(scanArray(&times).Scan([]byte("{{2020-01-02}}")) != nil)
+		is.True(scanArray(&times).Scan(1) != nil)
+	})
+}
@@ -3,6 +3,7 @@ package sq
 import (
 	"log"
 	"os"
+	"time"
 )
 
 // LogFlag is a flag that affects the verbosity of the Logger output.
@@ -23,8 +24,47 @@ type ExecFlag int
 // ExecFlags
 const (
 	ErowsAffected ExecFlag = 1 << iota
+	// Etransaction makes ExecBatched run all of its statements inside one
+	// transaction.
+	Etransaction
 )
 
+// LogAction indicates which action was performed on a query when a LogFunc is
+// invoked.
+type LogAction string
+
+// LogActions
+const (
+	LogActionToSQL LogAction = "ToSQL"
+	LogActionFetch LogAction = "Fetch"
+	LogActionExec  LogAction = "Exec"
+)
+
+// LogInfo contains the information about a query that is passed to a
+// LogFunc.
+type LogInfo struct {
+	LogFlag LogFlag
+	// LogSkip is the calldepth to pass to (*log.Logger).Output from within the
+	// LogFunc so that the log reports the caller of ToSQL/Fetch/Exec.
+	LogSkip   int
+	Query     string
+	Args      []interface{}
+	Action    LogAction
+	TimeTaken time.Duration
+	Err       error
+	// Fetch
+	RowsFetched int64
+	// Exec
+	ExecFlag     ExecFlag
+	RowsAffected int64
+}
+
+// LogFunc is a function that is called with the LogInfo of a query after it
+// is marshalled with ToSQL, fetched with Fetch or executed with Exec. Unlike
+// Log, it receives each part of the log as a separate field so that it can be
+// fed into a structured logger.
+type LogFunc func(LogInfo)
+
 var defaultLogger = log.New(os.Stdout, "[sq] ", log.Ldate|log.Ltime|log.Lshortfile|log.Lmsgprefix)
 
 // BaseQuery is a common query builder that can transform into a SelectQuery,
@@ -34,6 +74,7 @@ type BaseQuery struct {
 	DB      DB
 	Log     Logger
 	LogFlag LogFlag
+	LogFunc LogFunc
 	CTEs    []CTE
 }
 
@@ -45,6 +86,13 @@ func WithDefaultLog(flag LogFlag) BaseQuery {
 	}
 }
 
+// WithLogFunc creates a new BaseQuery with the LogFunc.
+func WithLogFunc(fn LogFunc) BaseQuery {
+	return BaseQuery{
+		LogFunc: fn,
+	}
+}
+
 // WithDB creates a new BaseQuery with the DB.
 func WithDB(db DB) BaseQuery {
 	return BaseQuery{
@@ -66,6 +114,12 @@ func (q BaseQuery) WithDefaultLog(flag LogFlag) BaseQuery {
 	return q
 }
 
+// WithLogFunc adds the LogFunc to the BaseQuery.
+func (q BaseQuery) WithLogFunc(fn LogFunc) BaseQuery {
+	q.LogFunc = fn
+	return q
+}
+
 // WithDB adds the DB to the BaseQuery.
 func (q BaseQuery) WithDB(db DB) BaseQuery {
 	q.DB = db
@@ -86,9 +140,15 @@ func (q BaseQuery) From(table Table) SelectQuery {
 		DB:        q.DB,
 		Log:       q.Log,
 		LogFlag:   q.LogFlag,
+		LogFunc:   q.LogFunc,
 	}
 }
 
+// Fromx transforms the BaseQuery into a SelectQuery.
+func (q BaseQuery) Fromx(joined JoinedTablesGetter) SelectQuery {
+	return q.From(nil).Fromx(joined)
+}
+
 // Select transforms the BaseQuery into a SelectQuery.
 func (q BaseQuery) Select(fields ...Field) SelectQuery {
 	return SelectQuery{
@@ -97,6 +157,7 @@ func (q BaseQuery) Select(fields ...Field) SelectQuery {
 		DB:           q.DB,
 		Log:          q.Log,
 		LogFlag:      q.LogFlag,
+		LogFunc:      q.LogFunc,
 	}
 }
 
@@ -108,6 +169,7 @@ func (q BaseQuery) SelectOne() SelectQuery {
 		DB:           q.DB,
 		Log:          q.Log,
 		LogFlag:      q.LogFlag,
+		LogFunc:      q.LogFunc,
 	}
 }
 
@@ -119,6 +181,7 @@ func (q BaseQuery) SelectAll() SelectQuery {
 		DB:           q.DB,
 		Log:          q.Log,
 		LogFlag:      q.LogFlag,
+		LogFunc:      q.LogFunc,
 	}
 }
 
@@ -130,6 +193,7 @@ func (q BaseQuery) SelectCount() SelectQuery {
 		DB:           q.DB,
 		Log:          q.Log,
 		LogFlag:      q.LogFlag,
+		LogFunc:      q.LogFunc,
 	}
 }
 
@@ -142,6 +206,7 @@ func (q BaseQuery) SelectDistinct(fields ...Field) SelectQuery {
 		DB:           q.DB,
 		Log:          q.Log,
 		LogFlag:      q.LogFlag,
+		LogFunc:      q.LogFunc,
 	}
 }
 
@@ -156,6 +221,7 @@ func (q BaseQuery) SelectDistinctOn(distinctFields ...Field) func(...Field) Sele
 			DB:           q.DB,
 			Log:          q.Log,
 			LogFlag:      q.LogFlag,
+			LogFunc:      q.LogFunc,
 		}
 	}
 }
@@ -169,6 +235,7 @@ func (q BaseQuery) Selectx(mapper func(*Row), accumulator func()) SelectQuery {
 		DB:          q.DB,
 		Log:         q.Log,
 		LogFlag:     q.LogFlag,
+		LogFunc:     q.LogFunc,
 	}
 }
 
@@ -180,6 +247,7 @@ func (q BaseQuery) SelectRowx(mapper func(*Row)) SelectQuery {
 		DB:        q.DB,
 		Log:       q.Log,
 		LogFlag:   q.LogFlag,
+		LogFunc:   q.LogFunc,
 	}
 }
 
@@ -191,6 +259,19 @@ func (q BaseQuery) InsertInto(table BaseTable) InsertQuery {
 		DB:        q.DB,
 		Log:       q.Log,
 		LogFlag:   q.LogFlag,
+		LogFunc:   q.LogFunc,
+	}
+}
+
+// CopyFrom transforms the BaseQuery into a CopyQuery.
+func (q BaseQuery) CopyFrom(table BaseTable, columns ...Field) CopyQuery {
+	return CopyQuery{
+		IntoTable:   table,
+		CopyColumns: columns,
+		DB:          q.DB,
+		Log:         q.Log,
+		LogFlag:     q.LogFlag,
+		LogFunc:     q.LogFunc,
 	}
 }
 
@@ -202,6 +283,7 @@ func (q BaseQuery) Update(table BaseTable) UpdateQuery {
 		DB:          q.DB,
 		Log:         q.Log,
 		LogFlag:     q.LogFlag,
+		LogFunc:     q.LogFunc,
 	}
 }
 
@@ -213,6 +295,7 @@ func (q BaseQuery) DeleteFrom(table BaseTable) DeleteQuery {
 		DB:        q.DB,
 		Log:       q.Log,
 		LogFlag:   q.LogFlag,
+		LogFunc:   q.LogFunc,
 	}
 }
 
@@ -225,6 +308,7 @@ func (q BaseQuery) Union(queries ...Query) VariadicQuery {
 		DB:       q.DB,
 		Log:      q.Log,
 		LogFlag:  q.LogFlag,
+		LogFunc:  q.LogFunc,
 	}
 }
 
@@ -237,5 +321,6 @@ func (q BaseQuery) UnionAll(queries ...Query) VariadicQuery {
 		DB:       q.DB,
 		Log:      q.Log,
 		LogFlag:  q.LogFlag,
+		LogFunc:  q.LogFunc,
 	}
 }
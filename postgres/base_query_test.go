@@ -1,6 +1,8 @@
 package sq
 
 import (
+	"database/sql"
+	"log"
 	"strings"
 	"testing"
 
@@ -31,6 +33,10 @@ func TestBaseQuery(t *testing.T) {
 	is.Equal(defaultLogger, base.Log)
 	is.Equal(Lstats, base.LogFlag)
 
+	// WithLogFunc
+	base = WithLogFunc(func(LogInfo) {}).WithDB(nil)
+	is.True(base.LogFunc != nil)
+
 	// SelectOne
 	sel = BaseQuery{}.SelectOne()
 	buf.Reset()
@@ -83,6 +89,10 @@ func TestBaseQuery(t *testing.T) {
 	ins.AppendSQL(buf, &args, nil)
 	is.Equal("INSERT INTO NULL", buf.String())
 
+	// CopyFrom
+	query, _ := BaseQuery{}.CopyFrom(nil).ToSQL()
+	is.Equal("COPY NULL FROM STDIN", query)
+
 	// Update
 	upd = BaseQuery{}.Update(nil)
 	buf.Reset()
@@ -95,3 +105,70 @@ func TestBaseQuery(t *testing.T) {
 	del.AppendSQL(buf, &args, nil)
 	is.Equal("DELETE FROM NULL", buf.String())
 }
+
+func TestBaseQuery_LogFunc(t *testing.T) {
+	is := is.New(t)
+	var info LogInfo
+	logBuf := &strings.Builder{}
+	logger := log.New(logBuf, "", log.Lshortfile)
+	logFunc := func(i LogInfo) {
+		info = i
+		logger.Output(i.LogSkip, i.Query)
+	}
+
+	// ToSQL
+	u := USERS().As("u")
+	q := WithLogFunc(logFunc).WithDefaultLog(Lverbose)
+	q.From(u).Where(u.USER_ID.EqInt(1)).Select(u.USER_ID).ToSQL()
+	is.Equal(LogActionToSQL, info.Action)
+	is.Equal(Lverbose, info.LogFlag)
+	is.Equal("SELECT u.user_id FROM public.users AS u WHERE u.user_id = $1", info.Query)
+	is.Equal([]interface{}{1}, info.Args)
+	is.True(strings.Contains(logBuf.String(), "base_query_test.go"))
+	q.InsertInto(u).Columns(u.DISPLAYNAME).Values("bob").ToSQL()
+	is.Equal("INSERT INTO public.users AS u (displayname) VALUES ($1)", info.Query)
+	q.Update(u).Set(u.DISPLAYNAME.SetString("bob")).ToSQL()
+	is.Equal("UPDATE public.users AS u SET displayname = $1", info.Query)
+	q.DeleteFrom(u).ToSQL()
+	is.Equal("DELETE FROM public.users AS u", info.Query)
+	// VariadicQuery does not inherit the LogFunc of its queries
+	info = LogInfo{}
+	Union(q.From(u).Select(u.USER_ID), q.From(u).Select(u.USER_ID)).ToSQL()
+	is.Equal("", info.Query)
+
+	if testing.Short() {
+		return
+	}
+	db, err := sql.Open("txdb", "BaseQuery_LogFunc")
+	is.NoErr(err)
+	defer db.Close()
+
+	// Fetch
+	logBuf.Reset()
+	var userIDs []int
+	var userID int
+	err = q.From(u).Where(u.USER_ID.LeInt(3)).Selectx(func(row *Row) {
+		userID = row.Int(u.USER_ID)
+	}, func() {
+		userIDs = append(userIDs, userID)
+	}).Fetch(db)
+	is.NoErr(err)
+	is.Equal(LogActionFetch, info.Action)
+	is.Equal(int64(len(userIDs)), info.RowsFetched)
+	is.Equal([]interface{}{3}, info.Args)
+	is.True(strings.Contains(logBuf.String(), "base_query_test.go"))
+
+	// Exec
+	rowsAffected, err := q.DeleteFrom(u).Where(u.USER_ID.EqInt(-1)).Exec(db, ErowsAffected)
+	is.NoErr(err)
+	is.Equal(LogActionExec, info.Action)
+	is.Equal(ErowsAffected, info.ExecFlag)
+	is.Equal(rowsAffected, info.RowsAffected)
+	is.Equal("DELETE FROM public.users AS u WHERE u.user_id = $1", info.Query)
+	is.Equal([]interface{}{-1}, info.Args)
+
+	// Errors are reported
+	err = q.From(u).Select(u.USER_ID).Fetch(nil)
+	is.True(err != nil)
+	is.Equal(err, info.Err)
+}
@@ -2,7 +2,8 @@ package sq
 
 import "strings"
 
-// BooleanField either represents a boolean column or a literal bool value.
+// BooleanField either represents a boolean column, a boolean expression or a
+// literal bool value.
 type BooleanField struct {
 	// BooleanField will be one of the following:
 
@@ -13,6 +14,15 @@ type BooleanField struct {
 	// | ?     | true |
 	value *bool
 
+	// 2) Boolean expression
+	// Examples of boolean expressions:
+	// | query                     | args |
+	// |---------------------------|------|
+	// | BOOL_AND(users.is_active) |      |
+	// | BOOL_OR(users.is_admin)   |      |
+	format *string
+	values []interface{}
+
 	// 3) Boolean column
 	// Examples of boolean columns:
 	// | query            | args |
@@ -39,6 +49,9 @@ func (f BooleanField) AppendSQLExclude(buf *strings.Builder, args *[]interface{}
 		// 1) Literal bool value
 		buf.WriteString("?")
 		*args = append(*args, *f.value)
+	case f.format != nil:
+		// 2) Boolean expression
+		expandValues(buf, args, excludedTableQualifiers, *f.format, f.values)
 	default:
 		// 3) Boolean column
 		tableQualifier := f.table.GetAlias()
@@ -157,6 +170,13 @@ func (f BooleanField) NullsLast() BooleanField {
 	return f
 }
 
+// ordering implements the orderedField interface.
+func (f BooleanField) ordering() (field Field, descending, nullsfirst *bool) {
+	descending, nullsfirst = f.descending, f.nullsfirst
+	f.descending, f.nullsfirst = nil, nil
+	return f, descending, nullsfirst
+}
+
 // IsNull returns an 'X IS NULL' Predicate.
 func (f BooleanField) IsNull() Predicate {
 	return CustomPredicate{
@@ -189,6 +209,17 @@ func (f BooleanField) Ne(field BooleanField) Predicate {
 	}
 }
 
+// Filter returns a new BooleanField that only aggregates the rows which satisfy
+// the predicate i.e. 'field FILTER (WHERE predicate)'. The BooleanField must be
+// an aggregate function e.g. BoolAnd(field).
+func (f BooleanField) Filter(predicate Predicate) BooleanField {
+	format := "? FILTER (WHERE ?)"
+	return BooleanField{
+		format: &format,
+		values: []interface{}{f, toplevelPredicate(predicate)},
+	}
+}
+
 // String implements the fmt.Stringer interface. It returns the string
 // representation of a BooleanField.
 func (f BooleanField) String() string {
@@ -0,0 +1,442 @@
+package sq
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Param is a named placeholder that can be rebound to a new value on a
+// CompiledQuery with Bind. Until it is bound, the Param itself is used as the
+// argument and will fail to be converted into a driver.Value.
+type Param string
+
+// AppendSQLExclude marshals the Param into a buffer and args slice.
+func (p Param) AppendSQLExclude(buf *strings.Builder, args *[]interface{}, params map[string]int, excludedTableQualifiers []string) {
+	buf.WriteString("?")
+	*args = append(*args, p)
+}
+
+// Value implements the driver.Valuer interface. It always returns an error
+// because an unbound Param should never reach the database.
+func (p Param) Value() (driver.Value, error) {
+	return nil, fmt.Errorf("param %q was not bound", string(p))
+}
+
+// GetAlias implements the Field interface. It always returns an empty string
+// because Params do not have aliases.
+func (p Param) GetAlias() string {
+	return ""
+}
+
+// GetName implements the Field interface. It returns the name of the Param.
+func (p Param) GetName() string {
+	return string(p)
+}
+
+// CompiledQuery is a query whose SQL string has already been generated. It can
+// be fetched or executed multiple times without paying the cost of generating
+// the query string again, and any Params inside it can be rebound to new
+// values with Bind.
+type CompiledQuery struct {
+	Query  string
+	Args   []interface{}
+	Params map[string][]int
+	// DB
+	DB          DB
+	RowMapper   func(*Row)
+	Accumulator func()
+	// Logging
+	Log     Logger
+	LogFlag LogFlag
+	LogFunc LogFunc
+	logSkip int
+}
+
+// compileQuery marshals the query into a CompiledQuery, noting down the
+// position of every Param in the args slice.
+func compileQuery(q Query) CompiledQuery {
+	buf := &strings.Builder{}
+	var args []interface{}
+	q.AppendSQL(buf, &args, nil)
+	cq := CompiledQuery{
+		Query:  buf.String(),
+		Args:   args,
+		Params: make(map[string][]int),
+	}
+	for i, arg := range args {
+		if p, ok := arg.(Param); ok {
+			cq.Params[string(p)] = append(cq.Params[string(p)], i)
+		}
+	}
+	return cq
+}
+
+// Compile marshals the SelectQuery into a CompiledQuery. If the SelectQuery
+// has a mapper function, the mapper function determines the SELECT fields in
+// the same way as Fetch does.
+func (q SelectQuery) Compile() CompiledQuery {
+	if q.RowMapper != nil {
+		r := &Row{}
+		q.RowMapper(r)
+		q.SelectFields = r.fields
+	}
+	logger, logFlag := q.Log, q.LogFlag
+	q.Log = nil
+	cq := compileQuery(q)
+	cq.DB = q.DB
+	cq.RowMapper = q.RowMapper
+	cq.Accumulator = q.Accumulator
+	cq.Log = logger
+	cq.LogFlag = logFlag
+	cq.LogFunc = q.LogFunc
+	return cq
+}
+
+// Compile marshals the InsertQuery into a CompiledQuery. If the InsertQuery
+// has a mapper function, the mapper function determines the RETURNING fields
+// in the same way as Fetch does.
+func (q InsertQuery) Compile() CompiledQuery {
+	if q.RowMapper != nil {
+		r := &Row{}
+		q.RowMapper(r)
+		q.ReturningFields = r.fields
+	}
+	logger, logFlag := q.Log, q.LogFlag
+	q.Log = nil
+	cq := compileQuery(q)
+	cq.DB = q.DB
+	cq.RowMapper = q.RowMapper
+	cq.Accumulator = q.Accumulator
+	cq.Log = logger
+	cq.LogFlag = logFlag
+	cq.LogFunc = q.LogFunc
+	return cq
+}
+
+// Compile marshals the UpdateQuery into a CompiledQuery. If the UpdateQuery
+// has a mapper function, the mapper function determines the RETURNING fields
+// in the same way as Fetch does.
+func (q UpdateQuery) Compile() CompiledQuery {
+	if q.RowMapper != nil {
+		r := &Row{}
+		q.RowMapper(r)
+		q.ReturningFields = r.fields
+	}
+	logger, logFlag := q.Log, q.LogFlag
+	q.Log = nil
+	cq := compileQuery(q)
+	cq.DB = q.DB
+	cq.RowMapper = q.RowMapper
+	cq.Accumulator = q.Accumulator
+	cq.Log = logger
+	cq.LogFlag = logFlag
+	cq.LogFunc = q.LogFunc
+	return cq
+}
+
+// Compile marshals the DeleteQuery into a CompiledQuery. If the DeleteQuery
+// has a mapper function, the mapper function determines the RETURNING fields
+// in the same way as Fetch does.
+func (q DeleteQuery) Compile() CompiledQuery {
+	if q.RowMapper != nil {
+		r := &Row{}
+		q.RowMapper(r)
+		q.ReturningFields = r.fields
+	}
+	logger, logFlag := q.Log, q.LogFlag
+	q.Log = nil
+	cq := compileQuery(q)
+	cq.DB = q.DB
+	cq.RowMapper = q.RowMapper
+	cq.Accumulator = q.Accumulator
+	cq.Log = logger
+	cq.LogFlag = logFlag
+	cq.LogFunc = q.LogFunc
+	return cq
+}
+
+// ToSQL returns the CompiledQuery's query string and args slice.
+func (q CompiledQuery) ToSQL() (string, []interface{}) {
+	return q.Query, q.Args
+}
+
+// Bind returns a new CompiledQuery with every occurrence of the named Param
+// replaced by the value. The value is passed to the database as-is, so it
+// cannot be a Field or a slice that needs to be expanded into multiple
+// placeholders. Binding a name that does not exist in the CompiledQuery is a
+// no-op.
+func (q CompiledQuery) Bind(name string, value interface{}) CompiledQuery {
+	indexes := q.Params[name]
+	if len(indexes) == 0 {
+		return q
+	}
+	args := make([]interface{}, len(q.Args))
+	copy(args, q.Args)
+	for _, i := range indexes {
+		args[i] = value
+	}
+	q.Args = args
+	return q
+}
+
+// Selectx sets the mapper function and accumulator function in the
+// CompiledQuery. The mapper function must scan the same fields that the query
+// was compiled with.
+func (q CompiledQuery) Selectx(mapper func(*Row), accumulator func()) CompiledQuery {
+	q.RowMapper = mapper
+	q.Accumulator = accumulator
+	return q
+}
+
+// SelectRowx sets the mapper function in the CompiledQuery. The mapper
+// function must scan the same fields that the query was compiled with.
+func (q CompiledQuery) SelectRowx(mapper func(*Row)) CompiledQuery {
+	q.RowMapper = mapper
+	return q
+}
+
+// logQuery writes the query and args to the CompiledQuery's Logger, if any.
+func (q CompiledQuery) logQuery() {
+	if q.Log == nil {
+		return
+	}
+	var logOutput string
+	switch {
+	case Lstats&q.LogFlag != 0:
+		logOutput = "\n----[ Executing query ]----\n" + q.Query + " " + fmt.Sprint(q.Args) +
+			"\n----[ with bind values ]----\n" + dollarInterpolate(q.Query, q.Args...)
+	case Linterpolate&q.LogFlag != 0:
+		logOutput = dollarInterpolate(q.Query, q.Args...)
+	default:
+		logOutput = q.Query + " " + fmt.Sprint(q.Args)
+	}
+	switch q.Log.(type) {
+	case *log.Logger:
+		_ = q.Log.Output(q.logSkip+2, logOutput)
+	default:
+		_ = q.Log.Output(q.logSkip+1, logOutput)
+	}
+}
+
+// Fetch will run CompiledQuery with the given DB. It then maps the results
+// based on the mapper function (and optionally runs the accumulator
+// function).
+func (q CompiledQuery) Fetch(db DB) (err error) {
+	q.logSkip += 1
+	return q.FetchContext(nil, db)
+}
+
+// FetchContext will run CompiledQuery with the given DB and context. It then
+// maps the results based on the mapper function (and optionally runs the
+// accumulator function).
+func (q CompiledQuery) FetchContext(ctx context.Context, db DB) (err error) {
+	q.logSkip += 1
+	return q.fetchContext(ctx, db)
+}
+
+// fetchContext runs the CompiledQuery's query string and args with the given
+// DB and context, and maps the results based on the mapper function (and
+// optionally runs the accumulator function).
+func (q CompiledQuery) fetchContext(ctx context.Context, db DB) (err error) {
+	logBuf := &strings.Builder{}
+	start := time.Now()
+	var rowcount int
+	if q.LogFunc != nil {
+		defer func() {
+			q.LogFunc(LogInfo{
+				LogFlag:     q.LogFlag,
+				LogSkip:     q.logSkip + 3,
+				Query:       q.Query,
+				Args:        q.Args,
+				Action:      LogActionFetch,
+				TimeTaken:   time.Since(start),
+				Err:         err,
+				RowsFetched: int64(rowcount),
+			})
+		}()
+	}
+	if db == nil {
+		if q.DB == nil {
+			return errors.New("DB cannot be nil")
+		}
+		db = q.DB
+	}
+	if q.RowMapper == nil {
+		return fmt.Errorf("cannot call Fetch/FetchContext without a mapper")
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			switch v := r.(type) {
+			case ExitCode:
+				if v != ExitPeacefully {
+					err = v
+				}
+			case error:
+				err = v
+			default:
+				err = fmt.Errorf("%#v", r)
+			}
+			return
+		}
+		if q.Log == nil {
+			return
+		}
+		elapsed := time.Since(start)
+		if Lresults&q.LogFlag != 0 && rowcount > 5 {
+			logBuf.WriteString("\n...")
+		}
+		if Lstats&q.LogFlag != 0 {
+			logBuf.WriteString("\n(Fetched ")
+			logBuf.WriteString(strconv.Itoa(rowcount))
+			logBuf.WriteString(" rows in ")
+			logBuf.WriteString(elapsed.String())
+			logBuf.WriteString(")")
+		}
+		if logBuf.Len() > 0 {
+			switch q.Log.(type) {
+			case *log.Logger:
+				_ = q.Log.Output(q.logSkip+2, logBuf.String())
+			default:
+				_ = q.Log.Output(q.logSkip+1, logBuf.String())
+			}
+		}
+	}()
+	r := newRow(db)
+	q.RowMapper(r)
+	q.logSkip += 1
+	q.logQuery()
+	r.rows, err = queryRows(ctx, db, q.Query, q.Args)
+	if err != nil {
+		return err
+	}
+	defer r.rows.Close()
+	if len(r.dest) == 0 {
+		return nil
+	}
+	tmpbuf := &strings.Builder{}
+	var tmpargs []interface{}
+	for r.rows.Next() {
+		rowcount++
+		err = r.rows.Scan(r.dest...)
+		if err != nil {
+			return mapperError(r, err)
+		}
+		if q.Log != nil && Lresults&q.LogFlag != 0 && rowcount <= 5 {
+			logBuf.WriteString("\n----[ Row ")
+			logBuf.WriteString(strconv.Itoa(rowcount))
+			logBuf.WriteString(" ]----")
+			for i := range r.dest {
+				tmpbuf.Reset()
+				tmpargs = tmpargs[:0]
+				r.fields[i].AppendSQLExclude(tmpbuf, &tmpargs, nil, nil)
+				logBuf.WriteString("\n")
+				logBuf.WriteString(dollarInterpolate(tmpbuf.String(), tmpargs...))
+				logBuf.WriteString(": ")
+				logBuf.WriteString(appendSQLDisplay(r.dest[i]))
+			}
+		}
+		r.index = 0
+		q.RowMapper(r)
+		if q.Accumulator == nil {
+			break
+		}
+		q.Accumulator()
+	}
+	if rowcount == 0 && q.Accumulator == nil {
+		return sql.ErrNoRows
+	}
+	if e := r.rows.Close(); e != nil {
+		return e
+	}
+	return r.rows.Err()
+}
+
+// Exec will execute the CompiledQuery with the given DB. It will only compute
+// the rowsAffected if the ErowsAffected Execflag is passed to it.
+func (q CompiledQuery) Exec(db DB, flag ExecFlag) (rowsAffected int64, err error) {
+	q.logSkip += 1
+	return q.ExecContext(nil, db, flag)
+}
+
+// ExecContext will execute the CompiledQuery with the given DB and context. It
+// will only compute the rowsAffected if the ErowsAffected Execflag is passed
+// to it.
+func (q CompiledQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (rowsAffected int64, err error) {
+	q.logSkip += 1
+	return q.execContext(ctx, db, flag, "Affected")
+}
+
+// execContext executes the CompiledQuery's query string and args with the
+// given DB and context. The verb describes the rowsAffected in the stats that
+// are logged.
+func (q CompiledQuery) execContext(ctx context.Context, db DB, flag ExecFlag, verb string) (rowsAffected int64, err error) {
+	logBuf := &strings.Builder{}
+	start := time.Now()
+	if q.LogFunc != nil {
+		defer func() {
+			q.LogFunc(LogInfo{
+				LogFlag:      q.LogFlag,
+				LogSkip:      q.logSkip + 3,
+				Query:        q.Query,
+				Args:         q.Args,
+				Action:       LogActionExec,
+				TimeTaken:    time.Since(start),
+				Err:          err,
+				ExecFlag:     flag,
+				RowsAffected: rowsAffected,
+			})
+		}()
+	}
+	if db == nil {
+		if q.DB == nil {
+			return rowsAffected, errors.New("DB cannot be nil")
+		}
+		db = q.DB
+	}
+	defer func() {
+		if q.Log == nil {
+			return
+		}
+		elapsed := time.Since(start)
+		if Lstats&q.LogFlag != 0 && ErowsAffected&flag != 0 {
+			logBuf.WriteString("\n(" + verb + " ")
+			logBuf.WriteString(strconv.FormatInt(rowsAffected, 10))
+			logBuf.WriteString(" rows in ")
+			logBuf.WriteString(elapsed.String())
+			logBuf.WriteString(")")
+		}
+		if logBuf.Len() > 0 {
+			switch q.Log.(type) {
+			case *log.Logger:
+				_ = q.Log.Output(q.logSkip+2, logBuf.String())
+			default:
+				_ = q.Log.Output(q.logSkip+1, logBuf.String())
+			}
+		}
+	}()
+	var res sql.Result
+	q.logSkip += 1
+	q.logQuery()
+	if ctx == nil {
+		res, err = db.Exec(q.Query, q.Args...)
+	} else {
+		res, err = db.ExecContext(ctx, q.Query, q.Args...)
+	}
+	if err != nil {
+		return rowsAffected, err
+	}
+	if res != nil && ErowsAffected&flag != 0 {
+		rowsAffected, err = res.RowsAffected()
+		if err != nil {
+			return rowsAffected, err
+		}
+	}
+	return rowsAffected, nil
+}
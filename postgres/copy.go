@@ -0,0 +1,328 @@
+package sq
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lib/pq"
+)
+
+// CopyQuery represents a COPY ... FROM STDIN statement, which bulk loads rows
+// into a table much faster than a multi-row INSERT.
+type CopyQuery struct {
+	// COPY
+	IntoTable   BaseTable
+	CopyColumns Fields
+	// FROM STDIN
+	ColumnMapper func(*Column)
+	RowSource    <-chan RowValue
+	// DB
+	DB DB
+	// Logging
+	Log     Logger
+	LogFlag LogFlag
+	LogFunc LogFunc
+	logSkip int
+}
+
+// pgxCopier is a PgxConn that can run COPY FROM, e.g. *pgx.Conn,
+// *pgxpool.Pool, *pgxpool.Conn or pgx.Tx.
+type pgxCopier interface {
+	CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error)
+}
+
+// stmtPreparer is a DB that can prepare statements, e.g. *sql.Tx.
+type stmtPreparer interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// CopyFrom creates a new CopyQuery. If no columns are given, the columns are
+// taken from the ColumnMapper passed to Valuesx.
+func CopyFrom(table BaseTable, columns ...Field) CopyQuery {
+	return CopyQuery{
+		IntoTable:   table,
+		CopyColumns: columns,
+	}
+}
+
+// Valuesx sets the column mapper of the CopyQuery. The mapper is used the
+// same way as in (InsertQuery).Valuesx, so every row it maps is held in
+// memory before being copied. Use Rows to stream rows instead.
+func (q CopyQuery) Valuesx(mapper func(*Column)) CopyQuery {
+	q.ColumnMapper = mapper
+	return q
+}
+
+// Rows sets the channel that the CopyQuery reads its rows from. Each RowValue
+// must hold one value for every column, in the same order as the columns
+// passed to CopyFrom. The rows are streamed until the channel is closed.
+func (q CopyQuery) Rows(rows <-chan RowValue) CopyQuery {
+	q.RowSource = rows
+	return q
+}
+
+// ToSQL returns the COPY statement of the CopyQuery. The rows are sent with
+// the COPY protocol rather than as args, so args is always empty.
+func (q CopyQuery) ToSQL() (string, []interface{}) {
+	columns, _, err := q.columnsAndRows()
+	if err != nil {
+		columns = q.CopyColumns
+	}
+	return q.statement(columns), nil
+}
+
+// statement returns the COPY statement for the columns.
+func (q CopyQuery) statement(columns Fields) string {
+	buf := &strings.Builder{}
+	var args []interface{}
+	var excludedTableQualifiers []string
+	buf.WriteString("COPY ")
+	if q.IntoTable == nil {
+		buf.WriteString("NULL")
+	} else {
+		q.IntoTable.AppendSQL(buf, &args, nil)
+		excludedTableQualifiers = append(excludedTableQualifiers, q.IntoTable.GetName(), q.IntoTable.GetAlias())
+	}
+	if len(columns) > 0 {
+		buf.WriteString(" (")
+		columns.AppendSQLExclude(buf, &args, nil, excludedTableQualifiers)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" FROM STDIN")
+	return buf.String()
+}
+
+// columnsAndRows runs the ColumnMapper (if any) and returns the columns and
+// rows that it mapped.
+func (q CopyQuery) columnsAndRows() (columns Fields, rows RowValues, err error) {
+	if q.ColumnMapper == nil {
+		return q.CopyColumns, nil, nil
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			switch v := r.(type) {
+			case error:
+				err = v
+			default:
+				err = fmt.Errorf("%#v", r)
+			}
+		}
+	}()
+	col := &Column{mode: colmodeInsert}
+	q.ColumnMapper(col)
+	if len(q.CopyColumns) == 0 {
+		return col.insertColumns, col.rowValues, nil
+	}
+	if len(col.insertColumns) != len(q.CopyColumns) {
+		return nil, nil, fmt.Errorf("CopyFrom has %d columns but the mapper set %d", len(q.CopyColumns), len(col.insertColumns))
+	}
+	for i, column := range q.CopyColumns {
+		if column.GetName() != col.insertColumns[i].GetName() {
+			return nil, nil, fmt.Errorf("CopyFrom column %d is %s but the mapper set %s", i, column.GetName(), col.insertColumns[i].GetName())
+		}
+	}
+	return q.CopyColumns, col.rowValues, nil
+}
+
+// Exec will copy the rows into the table with the given DB. It returns the
+// number of rows copied.
+func (q CopyQuery) Exec(db DB) (rowsCopied int64, err error) {
+	q.logSkip += 1
+	return q.ExecContext(nil, db)
+}
+
+// ExecContext will copy the rows into the table with the given DB and context.
+// It returns the number of rows copied.
+//
+// If the DB is a PgxDB the rows are copied natively with pgx. Otherwise the
+// DB must use the lib/pq driver: the rows are copied with pq.CopyIn inside a
+// transaction (or a savepoint, if db is already a transaction), so either
+// every row is copied or none are.
+func (q CopyQuery) ExecContext(ctx context.Context, db DB) (rowsCopied int64, err error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	start := time.Now()
+	columns, rows, err := q.columnsAndRows()
+	if err != nil {
+		columns = q.CopyColumns
+	}
+	query := q.statement(columns)
+	if q.LogFunc != nil {
+		defer func() {
+			q.LogFunc(LogInfo{
+				LogFlag:      q.LogFlag,
+				LogSkip:      q.logSkip + 3,
+				Query:        query,
+				Action:       LogActionExec,
+				TimeTaken:    time.Since(start),
+				Err:          err,
+				ExecFlag:     ErowsAffected,
+				RowsAffected: rowsCopied,
+			})
+		}()
+	}
+	if db == nil {
+		if q.DB == nil {
+			return rowsCopied, errors.New("DB cannot be nil")
+		}
+		db = q.DB
+	}
+	defer func() {
+		if q.Log == nil {
+			return
+		}
+		logBuf := &strings.Builder{}
+		logBuf.WriteString(query)
+		if Lstats&q.LogFlag != 0 {
+			logBuf.WriteString("\n(Copied ")
+			logBuf.WriteString(strconv.FormatInt(rowsCopied, 10))
+			logBuf.WriteString(" rows in ")
+			logBuf.WriteString(time.Since(start).String())
+			logBuf.WriteString(")")
+		}
+		switch q.Log.(type) {
+		case *log.Logger:
+			_ = q.Log.Output(q.logSkip+2, logBuf.String())
+		default:
+			_ = q.Log.Output(q.logSkip+1, logBuf.String())
+		}
+	}()
+	if q.IntoTable == nil {
+		return rowsCopied, errors.New("CopyFrom table cannot be nil")
+	}
+	if err != nil {
+		return rowsCopied, err
+	}
+	if len(columns) == 0 {
+		return rowsCopied, errors.New("CopyFrom needs at least one column")
+	}
+	if q.ColumnMapper != nil && q.RowSource != nil {
+		return rowsCopied, errors.New("cannot copy from both Valuesx and Rows")
+	}
+	columnNames := make([]string, len(columns))
+	for i, column := range columns {
+		columnNames[i] = column.GetName()
+	}
+	src := &copySource{ctx: ctx, rows: rows, rowSource: q.RowSource, numColumns: len(columns)}
+	var schema string
+	if tbl, ok := q.IntoTable.(interface{ GetSchema() string }); ok {
+		schema = tbl.GetSchema()
+	}
+	if pgxDB, ok := asPgxDB(db); ok {
+		copier, ok := pgxDB.Conn.(pgxCopier)
+		if !ok {
+			return rowsCopied, fmt.Errorf("%T does not support CopyFrom", pgxDB.Conn)
+		}
+		tableName := pgx.Identifier{q.IntoTable.GetName()}
+		if schema != "" {
+			tableName = pgx.Identifier{schema, q.IntoTable.GetName()}
+		}
+		return copier.CopyFrom(ctx, tableName, columnNames, src)
+	}
+	err = Tx(ctx, db, nil, func(tx DB) error {
+		preparer, ok := tx.(stmtPreparer)
+		if !ok {
+			return fmt.Errorf("%T does not support CopyFrom", tx)
+		}
+		var copyIn string
+		if schema != "" {
+			copyIn = pq.CopyInSchema(schema, q.IntoTable.GetName(), columnNames...)
+		} else {
+			copyIn = pq.CopyIn(q.IntoTable.GetName(), columnNames...)
+		}
+		stmt, err := preparer.PrepareContext(ctx, copyIn)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+		var n int64
+		for src.Next() {
+			values, err := src.Values()
+			if err != nil {
+				return err
+			}
+			_, err = stmt.ExecContext(ctx, values...)
+			if err != nil {
+				return err
+			}
+			n++
+		}
+		if err = src.Err(); err != nil {
+			return err
+		}
+		_, err = stmt.ExecContext(ctx)
+		if err != nil {
+			return err
+		}
+		rowsCopied = n
+		return stmt.Close()
+	})
+	return rowsCopied, err
+}
+
+// copySource reads rows from either a RowValues or a channel of RowValue. It
+// implements pgx.CopyFromSource.
+type copySource struct {
+	ctx        context.Context
+	rows       RowValues
+	rowSource  <-chan RowValue
+	numColumns int
+	index      int
+	current    RowValue
+	err        error
+}
+
+// Next implements pgx.CopyFromSource.
+func (src *copySource) Next() bool {
+	if src.err != nil {
+		return false
+	}
+	switch {
+	case src.rowSource != nil:
+		select {
+		case row, ok := <-src.rowSource:
+			if !ok {
+				return false
+			}
+			src.current = row
+		case <-src.ctx.Done():
+			src.err = src.ctx.Err()
+			return false
+		}
+	case src.index < len(src.rows):
+		src.current = src.rows[src.index]
+	default:
+		return false
+	}
+	src.index++
+	if len(src.current) != src.numColumns {
+		src.err = fmt.Errorf("row %d has %d values but there are %d columns", src.index, len(src.current), src.numColumns)
+		return false
+	}
+	for _, value := range src.current {
+		switch value.(type) {
+		case SQLAppender, SQLExcludeAppender:
+			src.err = fmt.Errorf("row %d: COPY only supports literal values, not %T", src.index, value)
+			return false
+		}
+	}
+	return true
+}
+
+// Values implements pgx.CopyFromSource.
+func (src *copySource) Values() ([]interface{}, error) {
+	return src.current, nil
+}
+
+// Err implements pgx.CopyFromSource.
+func (src *copySource) Err() error {
+	return src.err
+}
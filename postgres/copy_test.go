@@ -0,0 +1,205 @@
+package sq
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/matryer/is"
+)
+
+func TestCopyQuery_ToSQL(t *testing.T) {
+	type TT struct {
+		description string
+		q           CopyQuery
+		wantQuery   string
+	}
+	u := USERS().As("u")
+	tests := []TT{
+		{"empty", CopyQuery{}, "COPY NULL FROM STDIN"},
+		{"no columns", CopyFrom(u), "COPY public.users FROM STDIN"},
+		{"columns", CopyFrom(u, u.DISPLAYNAME, u.EMAIL), "COPY public.users (displayname, email) FROM STDIN"},
+		{
+			"columns from mapper",
+			CopyFrom(u).Valuesx(func(col *Column) {
+				col.SetString(u.DISPLAYNAME, "aaa")
+				col.SetString(u.EMAIL, "aaa@email.com")
+			}),
+			"COPY public.users (displayname, email) FROM STDIN",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			gotQuery, gotArgs := tt.q.ToSQL()
+			is.Equal(tt.wantQuery, gotQuery)
+			is.Equal(0, len(gotArgs))
+		})
+	}
+}
+
+func TestCopyQuery_columnsAndRows(t *testing.T) {
+	is := is.New(t)
+	u := USERS()
+	mapper := func(col *Column) {
+		for _, name := range []string{"aaa", "bbb"} {
+			col.SetString(u.DISPLAYNAME, name)
+			col.SetString(u.EMAIL, name+"@email.com")
+		}
+	}
+
+	columns, rows, err := CopyFrom(u).Valuesx(mapper).columnsAndRows()
+	is.NoErr(err)
+	is.Equal(Fields{u.DISPLAYNAME, u.EMAIL}, columns)
+	is.Equal(RowValues{{"aaa", "aaa@email.com"}, {"bbb", "bbb@email.com"}}, rows)
+
+	// Columns must match the mapper
+	_, _, err = CopyFrom(u, u.DISPLAYNAME, u.EMAIL).Valuesx(mapper).columnsAndRows()
+	is.NoErr(err)
+	_, _, err = CopyFrom(u, u.DISPLAYNAME).Valuesx(mapper).columnsAndRows()
+	is.True(err != nil)
+	_, _, err = CopyFrom(u, u.EMAIL, u.DISPLAYNAME).Valuesx(mapper).columnsAndRows()
+	is.True(err != nil)
+
+	// Panicking mapper
+	_, _, err = CopyFrom(u).Valuesx(func(col *Column) { panic("oops") }).columnsAndRows()
+	is.True(err != nil)
+}
+
+func TestCopySource(t *testing.T) {
+	is := is.New(t)
+	u := USERS()
+
+	// RowValues
+	src := &copySource{ctx: context.Background(), rows: RowValues{{1, "a"}, {2, "b"}}, numColumns: 2}
+	var got []RowValue
+	for src.Next() {
+		values, err := src.Values()
+		is.NoErr(err)
+		got = append(got, values)
+	}
+	is.NoErr(src.Err())
+	is.Equal([]RowValue{{1, "a"}, {2, "b"}}, got)
+
+	// Channel
+	ch := make(chan RowValue, 2)
+	ch <- RowValue{1, "a"}
+	ch <- RowValue{2, "b"}
+	close(ch)
+	src = &copySource{ctx: context.Background(), rowSource: ch, numColumns: 2}
+	got = got[:0]
+	for src.Next() {
+		values, err := src.Values()
+		is.NoErr(err)
+		got = append(got, values)
+	}
+	is.NoErr(src.Err())
+	is.Equal([]RowValue{{1, "a"}, {2, "b"}}, got)
+
+	// Wrong number of values
+	src = &copySource{ctx: context.Background(), rows: RowValues{{1}}, numColumns: 2}
+	is.True(!src.Next())
+	is.True(src.Err() != nil)
+
+	// SQL expressions are not supported
+	src = &copySource{ctx: context.Background(), rows: RowValues{{u.USER_ID}}, numColumns: 1}
+	is.True(!src.Next())
+	is.True(src.Err() != nil)
+
+	// Cancelled context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	src = &copySource{ctx: ctx, rowSource: make(chan RowValue), numColumns: 1}
+	is.True(!src.Next())
+	is.True(errors.Is(src.Err(), context.Canceled))
+}
+
+func TestCopyQuery_Exec(t *testing.T) {
+	u := USERS()
+	is := is.New(t)
+
+	// Missing DB
+	_, err := CopyFrom(u, u.DISPLAYNAME).Exec(nil)
+	is.True(err != nil)
+
+	if testing.Short() {
+		return
+	}
+	db, err := sql.Open("txdb", "CopyQuery_Exec")
+	is.NoErr(err)
+	defer db.Close()
+	countUsers := func() int {
+		var count int
+		err := WithDB(db).From(u).SelectRowx(func(row *Row) {
+			row.ScanInto(&count, Count())
+		}).Fetch(nil)
+		is.NoErr(err)
+		return count
+	}
+	count := countUsers()
+
+	// Valuesx
+	rowsCopied, err := WithDefaultLog(Lstats).WithDB(db).CopyFrom(u).Valuesx(func(col *Column) {
+		for _, name := range []string{"a1", "a2", "a3"} {
+			col.SetString(u.DISPLAYNAME, name)
+			col.SetString(u.EMAIL, name+"@copied.com")
+		}
+	}).Exec(nil)
+	is.NoErr(err)
+	is.Equal(int64(3), rowsCopied)
+	is.Equal(count+3, countUsers())
+
+	// Rows
+	ch := make(chan RowValue)
+	go func() {
+		defer close(ch)
+		for _, name := range []string{"b1", "b2"} {
+			ch <- RowValue{name, name + "@copied.com"}
+		}
+	}()
+	rowsCopied, err = CopyFrom(u, u.DISPLAYNAME, u.EMAIL).Rows(ch).Exec(db)
+	is.NoErr(err)
+	is.Equal(int64(2), rowsCopied)
+	is.Equal(count+5, countUsers())
+
+	// No columns
+	_, err = CopyFrom(u).Rows(ch).Exec(db)
+	is.True(err != nil)
+}
+
+// fakePgxCopier is a fakePgxConn that also supports CopyFrom.
+type fakePgxCopier struct {
+	fakePgxConn
+	tableName   pgx.Identifier
+	columnNames []string
+}
+
+func (conn *fakePgxCopier) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	conn.tableName, conn.columnNames = tableName, columnNames
+	var n int64
+	for rowSrc.Next() {
+		if _, err := rowSrc.Values(); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, rowSrc.Err()
+}
+
+func TestCopyQuery_PgxDB(t *testing.T) {
+	is := is.New(t)
+	u := USERS()
+	conn := &fakePgxCopier{}
+	rowsCopied, err := CopyFrom(u, u.DISPLAYNAME).Valuesx(func(col *Column) {
+		col.SetString(u.DISPLAYNAME, "a1")
+		col.SetString(u.DISPLAYNAME, "a2")
+	}).Exec(&PgxDB{Conn: conn})
+	is.NoErr(err)
+	is.Equal(int64(2), rowsCopied)
+	is.Equal(pgx.Identifier{"public", "users"}, conn.tableName)
+	is.Equal([]string{"displayname"}, conn.columnNames)
+}
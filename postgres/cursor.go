@@ -0,0 +1,234 @@
+package sq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cursor iterates over the results of a SelectQuery one row at a time. It is
+// created by (SelectQuery).Iterate, and must be closed once it is no longer
+// needed.
+//
+//	cursor, err := q.Iterate(ctx, db)
+//	if err != nil {
+//		return err
+//	}
+//	defer cursor.Close()
+//	for cursor.Next() {
+//		err := cursor.Scan()
+//		if err != nil {
+//			return err
+//		}
+//		// the variables assigned by the mapper now hold the current row
+//	}
+//	return cursor.Err()
+type Cursor struct {
+	row      *Row
+	mapper   func(*Row)
+	closed   bool
+	err      error
+	rowcount int
+	start    time.Time
+	query    string
+	args     []interface{}
+	logBuf   *strings.Builder
+	log      Logger
+	logFlag  LogFlag
+	logFunc  LogFunc
+	logSkip  int
+}
+
+// Iterate will run the SelectQuery with the given DB and context and return
+// a Cursor over its results. Unlike FetchContext, the rows are only read as
+// the Cursor is advanced, so the caller is free to stop early or interleave
+// several Cursors. The mapper defines the fields that are selected, exactly as
+// in FetchContext, and is run on every call to (*Cursor).Scan.
+func (q SelectQuery) Iterate(ctx context.Context, db DB) (*Cursor, error) {
+	cursor := &Cursor{
+		mapper:  q.RowMapper,
+		start:   time.Now(),
+		logBuf:  &strings.Builder{},
+		log:     q.Log,
+		logFlag: q.LogFlag,
+		logFunc: q.LogFunc,
+		logSkip: q.logSkip,
+	}
+	if db == nil {
+		db = q.DB
+	}
+	if db == nil {
+		cursor.err = errors.New("DB cannot be nil")
+	} else if q.RowMapper == nil {
+		cursor.err = fmt.Errorf("cannot call Iterate without a mapper")
+	}
+	if cursor.err != nil {
+		cursor.closed = true
+		cursor.logFetch()
+		return nil, cursor.err
+	}
+	r := newRow(db)
+	q.RowMapper(r)
+	q.SelectFields = r.fields
+	tmpbuf := &strings.Builder{}
+	var tmpargs []interface{}
+	q.logSkip += 1
+	q.AppendSQL(tmpbuf, &tmpargs, nil)
+	cursor.query, cursor.args = tmpbuf.String(), tmpargs
+	var err error
+	r.rows, err = queryRows(ctx, db, cursor.query, cursor.args)
+	if err != nil {
+		cursor.closed = true
+		cursor.err = err
+		cursor.logFetch()
+		return nil, err
+	}
+	cursor.row = r
+	return cursor, nil
+}
+
+// Next prepares the next row for reading with Scan. It returns false when
+// there are no more rows or an error occurred, in which case the Cursor is
+// closed and Err should be checked.
+func (c *Cursor) Next() bool {
+	if c.closed {
+		return false
+	}
+	if !c.row.rows.Next() {
+		c.err = c.row.rows.Err()
+		_ = c.Close()
+		return false
+	}
+	c.rowcount++
+	return true
+}
+
+// Scan scans the current row into the Row and runs the mapper on it.
+func (c *Cursor) Scan() (err error) {
+	if c.closed {
+		return errors.New("Scan called on a closed Cursor")
+	}
+	r := c.row
+	if len(r.dest) > 0 {
+		err = r.rows.Scan(r.dest...)
+		if err != nil {
+			c.err = mapperError(r, err)
+			return c.err
+		}
+	}
+	if c.log != nil && Lresults&c.logFlag != 0 && c.rowcount <= 5 {
+		c.logBuf.WriteString("\n----[ Row ")
+		c.logBuf.WriteString(strconv.Itoa(c.rowcount))
+		c.logBuf.WriteString(" ]----")
+		tmpbuf := &strings.Builder{}
+		var tmpargs []interface{}
+		for i := range r.dest {
+			tmpbuf.Reset()
+			tmpargs = tmpargs[:0]
+			r.fields[i].AppendSQLExclude(tmpbuf, &tmpargs, nil, nil)
+			c.logBuf.WriteString("\n")
+			c.logBuf.WriteString(dollarInterpolate(tmpbuf.String(), tmpargs...))
+			c.logBuf.WriteString(": ")
+			c.logBuf.WriteString(appendSQLDisplay(r.dest[i]))
+		}
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			switch v := r.(type) {
+			case ExitCode:
+				if v != ExitPeacefully {
+					err, c.err = v, v
+				}
+				_ = c.Close()
+			case error:
+				err, c.err = v, v
+			default:
+				err = fmt.Errorf("%#v", r)
+				c.err = err
+			}
+		}
+	}()
+	r.index = 0
+	c.mapper(r)
+	return nil
+}
+
+// Err returns the error, if any, that was encountered during iteration.
+func (c *Cursor) Err() error {
+	return c.err
+}
+
+// Close closes the Cursor, preventing further iteration. It is safe to call
+// Close more than once.
+func (c *Cursor) Close() error {
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	err := c.row.rows.Close()
+	if c.err == nil {
+		c.err = err
+	}
+	c.logFetch()
+	return err
+}
+
+// logFetch logs the Cursor's query once it is closed.
+func (c *Cursor) logFetch() {
+	if c.logFunc != nil {
+		c.logFunc(LogInfo{
+			LogFlag:     c.logFlag,
+			LogSkip:     c.logSkip + 3,
+			Query:       c.query,
+			Args:        c.args,
+			Action:      LogActionFetch,
+			TimeTaken:   time.Since(c.start),
+			Err:         c.err,
+			RowsFetched: int64(c.rowcount),
+		})
+	}
+	if c.log == nil {
+		return
+	}
+	if Lresults&c.logFlag != 0 && c.rowcount > 5 {
+		c.logBuf.WriteString("\n...")
+	}
+	if Lstats&c.logFlag != 0 {
+		c.logBuf.WriteString("\n(Fetched ")
+		c.logBuf.WriteString(strconv.Itoa(c.rowcount))
+		c.logBuf.WriteString(" rows in ")
+		c.logBuf.WriteString(time.Since(c.start).String())
+		c.logBuf.WriteString(")")
+	}
+	if c.logBuf.Len() > 0 {
+		switch c.log.(type) {
+		case *log.Logger:
+			_ = c.log.Output(c.logSkip+3, c.logBuf.String())
+		default:
+			_ = c.log.Output(c.logSkip+2, c.logBuf.String())
+		}
+	}
+}
+
+// mapperError describes which fields the mapper was scanning into when the
+// scan failed, so that a wrong mapper is easy to spot.
+func mapperError(r *Row, err error) error {
+	errbuf := &strings.Builder{}
+	tmpbuf := &strings.Builder{}
+	var tmpargs []interface{}
+	for i := range r.dest {
+		tmpbuf.Reset()
+		tmpargs = tmpargs[:0]
+		r.fields[i].AppendSQLExclude(tmpbuf, &tmpargs, nil, nil)
+		errbuf.WriteString("\n" +
+			strconv.Itoa(i) + ") " +
+			dollarInterpolate(tmpbuf.String(), tmpargs...) + " => " +
+			reflect.TypeOf(r.dest[i]).String())
+	}
+	return fmt.Errorf("Please check if your mapper function is correct:%s\n%w", errbuf.String(), err)
+}
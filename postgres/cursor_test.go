@@ -0,0 +1,123 @@
+package sq
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestSelectQuery_Iterate(t *testing.T) {
+	is := is.New(t)
+	u := USERS()
+
+	var info LogInfo
+	logFunc := func(i LogInfo) { info = i }
+
+	// Missing DB
+	_, err := WithLogFunc(logFunc).From(u).SelectRowx(func(row *Row) {}).Iterate(nil, nil)
+	is.True(err != nil)
+	is.Equal(err, info.Err)
+
+	// No mapper
+	info = LogInfo{}
+	_, err = WithLogFunc(logFunc).From(u).Iterate(nil, &sql.DB{})
+	is.True(err != nil)
+	is.Equal(err, info.Err)
+
+	if testing.Short() {
+		return
+	}
+	db, err := sql.Open("txdb", "SelectQuery_Iterate")
+	is.NoErr(err)
+	defer db.Close()
+
+	// Iterate over every row
+	var userID int
+	var displayname string
+	cursor, err := WithDefaultLog(Lverbose).
+		WithDB(db).
+		From(u).
+		Where(u.USER_ID.LeInt(10)).
+		OrderBy(u.USER_ID).
+		SelectRowx(func(row *Row) {
+			userID = row.Int(u.USER_ID)
+			displayname = row.String(u.DISPLAYNAME)
+		}).
+		Iterate(nil, nil)
+	is.NoErr(err)
+	var userIDs []int
+	for cursor.Next() {
+		err = cursor.Scan()
+		is.NoErr(err)
+		is.True(displayname != "")
+		userIDs = append(userIDs, userID)
+	}
+	is.NoErr(cursor.Err())
+	is.NoErr(cursor.Close())
+	is.Equal([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, userIDs)
+	is.True(!cursor.Next())
+	is.True(cursor.Scan() != nil)
+
+	// Stop early
+	cursor, err = WithDB(db).
+		From(u).
+		OrderBy(u.USER_ID).
+		SelectRowx(func(row *Row) { userID = row.Int(u.USER_ID) }).
+		Iterate(nil, nil)
+	is.NoErr(err)
+	is.True(cursor.Next())
+	is.NoErr(cursor.Scan())
+	is.Equal(1, userID)
+	is.NoErr(cursor.Close())
+	is.NoErr(cursor.Close())
+
+	// ExitPeacefully closes the cursor
+	cursor, err = WithDB(db).
+		From(u).
+		OrderBy(u.USER_ID).
+		SelectRowx(func(row *Row) {
+			userID = row.Int(u.USER_ID)
+			if userID == 2 {
+				panic(ExitPeacefully)
+			}
+		}).
+		Iterate(nil, nil)
+	is.NoErr(err)
+	var count int
+	for cursor.Next() {
+		is.NoErr(cursor.Scan())
+		count++
+	}
+	is.NoErr(cursor.Err())
+	is.Equal(2, count)
+
+	// Mapper diagnostics
+	var wrong int
+	info = LogInfo{}
+	cursor, err = WithDB(db).
+		WithLogFunc(logFunc).
+		From(u).
+		SelectRowx(func(row *Row) { row.ScanInto(&wrong, u.DISPLAYNAME) }).
+		Iterate(nil, nil)
+	is.NoErr(err)
+	is.True(cursor.Next())
+	err = cursor.Scan()
+	is.True(err != nil)
+	is.True(strings.Contains(err.Error(), "Please check if your mapper function is correct"))
+	is.Equal(err, cursor.Err())
+	is.NoErr(cursor.Close())
+	is.Equal(err, info.Err)
+
+	// simulate timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	_, err = WithDB(db).
+		From(u).
+		SelectRowx(func(row *Row) {}).
+		Iterate(ctx, nil)
+	is.True(errors.Is(err, context.DeadlineExceeded))
+}
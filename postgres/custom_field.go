@@ -82,6 +82,13 @@ func (f CustomField) NullsLast() CustomField {
 	return f
 }
 
+// ordering implements the orderedField interface.
+func (f CustomField) ordering() (field Field, descending, nullsfirst *bool) {
+	descending, nullsfirst = f.IsDesc, f.IsNullsFirst
+	f.IsDesc, f.IsNullsFirst = nil, nil
+	return f, descending, nullsfirst
+}
+
 // IsNull returns an 'X IS NULL' Predicate.
 func (f CustomField) IsNull() Predicate {
 	return CustomPredicate{
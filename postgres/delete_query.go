@@ -33,6 +33,7 @@ type DeleteQuery struct {
 	// Logging
 	Log     Logger
 	LogFlag LogFlag
+	LogFunc LogFunc
 	logSkip int
 }
 
@@ -42,6 +43,15 @@ func (q DeleteQuery) ToSQL() (string, []interface{}) {
 	buf := &strings.Builder{}
 	var args []interface{}
 	q.AppendSQL(buf, &args, nil)
+	if q.LogFunc != nil {
+		q.LogFunc(LogInfo{
+			LogFlag: q.LogFlag,
+			LogSkip: q.logSkip + 2,
+			Query:   buf.String(),
+			Args:    args,
+			Action:  LogActionToSQL,
+		})
+	}
 	return buf.String(), args
 }
 
@@ -79,13 +89,9 @@ func (q DeleteQuery) AppendSQL(buf *strings.Builder, args *[]interface{}, params
 			v.NestThis().AppendSQL(buf, args, nil)
 			buf.WriteString(")")
 		default:
-			q.FromTable.AppendSQL(buf, args, nil)
-		}
-		alias := q.UsingTable.GetAlias()
-		if alias != "" {
-			buf.WriteString(" AS ")
-			buf.WriteString(alias)
+			q.UsingTable.AppendSQL(buf, args, nil)
 		}
+		appendTableAlias(buf, q.UsingTable)
 	}
 	// JOIN
 	if len(q.JoinTables) > 0 {
@@ -159,6 +165,15 @@ func (q DeleteQuery) Using(table Table) DeleteQuery {
 	return q
 }
 
+// Usingx sets the USING table of the DeleteQuery to the JoinedTables' FROM
+// table, followed by its JoinTables.
+func (q DeleteQuery) Usingx(joined JoinedTablesGetter) DeleteQuery {
+	jt := joined.GetJoinedTables()
+	q.UsingTable = jt.FromTable
+	q.JoinTables = jt.prependJoins(q.JoinTables)
+	return q
+}
+
 // Join joins a new table to the DeleteQuery based on the predicates.
 func (q DeleteQuery) Join(table Table, predicate Predicate, predicates ...Predicate) DeleteQuery {
 	predicates = append([]Predicate{predicate}, predicates...)
@@ -266,6 +281,25 @@ func (q DeleteQuery) Fetch(db DB) (err error) {
 // maps the results based on the mapper function (and optionally runs the
 // accumulator function).
 func (q DeleteQuery) FetchContext(ctx context.Context, db DB) (err error) {
+	logBuf := &strings.Builder{}
+	start := time.Now()
+	var rowcount int
+	var logQuery string
+	var logArgs []interface{}
+	if q.LogFunc != nil {
+		defer func() {
+			q.LogFunc(LogInfo{
+				LogFlag:     q.LogFlag,
+				LogSkip:     q.logSkip + 3,
+				Query:       logQuery,
+				Args:        logArgs,
+				Action:      LogActionFetch,
+				TimeTaken:   time.Since(start),
+				Err:         err,
+				RowsFetched: int64(rowcount),
+			})
+		}()
+	}
 	if db == nil {
 		if q.DB == nil {
 			return errors.New("DB cannot be nil")
@@ -275,9 +309,6 @@ func (q DeleteQuery) FetchContext(ctx context.Context, db DB) (err error) {
 	if q.RowMapper == nil {
 		return fmt.Errorf("cannot call Fetch/FetchContext without a mapper")
 	}
-	logBuf := &strings.Builder{}
-	start := time.Now()
-	var rowcount int
 	defer func() {
 		if r := recover(); r != nil {
 			switch v := r.(type) {
@@ -315,18 +346,18 @@ func (q DeleteQuery) FetchContext(ctx context.Context, db DB) (err error) {
 			}
 		}
 	}()
-	r := &Row{}
+	r := newRow(db)
 	q.RowMapper(r)
 	q.ReturningFields = r.fields
 	tmpbuf := &strings.Builder{}
 	var tmpargs []interface{}
 	q.logSkip += 1
 	q.AppendSQL(tmpbuf, &tmpargs, nil)
-	if ctx == nil {
-		r.rows, err = db.Query(tmpbuf.String(), tmpargs...)
-	} else {
-		r.rows, err = db.QueryContext(ctx, tmpbuf.String(), tmpargs...)
+	if q.LogFunc != nil {
+		logQuery = tmpbuf.String()
+		logArgs = append(logArgs, tmpargs...)
 	}
+	r.rows, err = queryRows(ctx, db, tmpbuf.String(), tmpargs)
 	if err != nil {
 		return err
 	}
@@ -390,14 +421,31 @@ func (q DeleteQuery) Exec(db DB, flag ExecFlag) (rowsAffected int64, err error)
 // ExecContext will execute the DeleteQuery with the given DB and context. It will
 // only compute the rowsAffected if the ErowsAffected Execflag is passed to it.
 func (q DeleteQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (rowsAffected int64, err error) {
+	logBuf := &strings.Builder{}
+	start := time.Now()
+	var logQuery string
+	var logArgs []interface{}
+	if q.LogFunc != nil {
+		defer func() {
+			q.LogFunc(LogInfo{
+				LogFlag:      q.LogFlag,
+				LogSkip:      q.logSkip + 3,
+				Query:        logQuery,
+				Args:         logArgs,
+				Action:       LogActionExec,
+				TimeTaken:    time.Since(start),
+				Err:          err,
+				ExecFlag:     flag,
+				RowsAffected: rowsAffected,
+			})
+		}()
+	}
 	if db == nil {
 		if q.DB == nil {
 			return rowsAffected, errors.New("DB cannot be nil")
 		}
 		db = q.DB
 	}
-	logBuf := &strings.Builder{}
-	start := time.Now()
 	defer func() {
 		if q.Log == nil {
 			return
@@ -424,6 +472,9 @@ func (q DeleteQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (row
 	var tmpargs []interface{}
 	q.logSkip += 1
 	q.AppendSQL(tmpbuf, &tmpargs, nil)
+	if q.LogFunc != nil {
+		logQuery, logArgs = tmpbuf.String(), tmpargs
+	}
 	if ctx == nil {
 		res, err = db.Exec(tmpbuf.String(), tmpargs...)
 	} else {
@@ -0,0 +1,253 @@
+package sq
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// connGetter is a DB that can hand out a single connection, e.g. *sql.DB.
+type connGetter interface {
+	Conn(ctx context.Context) (*sql.Conn, error)
+}
+
+// sqlConn wraps a *sql.Conn so that it can be used as a DB.
+type sqlConn struct {
+	*sql.Conn
+}
+
+// Query implements the DB interface.
+func (conn sqlConn) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return conn.QueryContext(context.Background(), query, args...)
+}
+
+// Exec implements the DB interface.
+func (conn sqlConn) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return conn.ExecContext(context.Background(), query, args...)
+}
+
+var cursorCount uint64
+
+// WithHold makes FetchCursor declare its cursor WITH HOLD.
+func (q SelectQuery) WithHold() SelectQuery {
+	q.CursorWithHold = true
+	return q
+}
+
+// FetchCursor will run the SelectQuery with the given DB and context through a
+// server-side cursor, fetching batchSize rows at a time. Each row is passed to
+// the mapper and the accumulator the same way as in FetchContext, but only one
+// batch of rows is held at any time. The cursor is closed once every row has
+// been fetched, or as soon as an error occurs.
+//
+// By default the cursor is declared inside a transaction (or a savepoint, if
+// db is already a transaction) that lasts until the cursor is closed. If the
+// query was marked WithHold, the cursor is declared WITH HOLD instead: it does
+// not need a transaction, so the rows are fetched on a single connection
+// without keeping a transaction open. A PgxDB used WithHold must wrap a single
+// connection rather than a pool.
+func (q SelectQuery) FetchCursor(ctx context.Context, db DB, batchSize int) (err error) {
+	logBuf := &strings.Builder{}
+	start := time.Now()
+	var rowcount int
+	var logQuery string
+	var logArgs []interface{}
+	if q.LogFunc != nil {
+		defer func() {
+			q.LogFunc(LogInfo{
+				LogFlag:     q.LogFlag,
+				LogSkip:     q.logSkip + 3,
+				Query:       logQuery,
+				Args:        logArgs,
+				Action:      LogActionFetch,
+				TimeTaken:   time.Since(start),
+				Err:         err,
+				RowsFetched: int64(rowcount),
+			})
+		}()
+	}
+	if db == nil {
+		if q.DB == nil {
+			return errors.New("DB cannot be nil")
+		}
+		db = q.DB
+	}
+	if q.RowMapper == nil {
+		return fmt.Errorf("cannot call FetchCursor without a mapper")
+	}
+	if batchSize <= 0 {
+		return fmt.Errorf("FetchCursor batchSize must be positive, got %d", batchSize)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			switch v := r.(type) {
+			case ExitCode:
+				if v != ExitPeacefully {
+					err = v
+				}
+			case error:
+				err = v
+			default:
+				err = fmt.Errorf("%#v", r)
+			}
+			return
+		}
+		if q.Log == nil {
+			return
+		}
+		elapsed := time.Since(start)
+		if Lresults&q.LogFlag != 0 && rowcount > 5 {
+			logBuf.WriteString("\n...")
+		}
+		if Lstats&q.LogFlag != 0 {
+			logBuf.WriteString("\n(Fetched ")
+			logBuf.WriteString(strconv.Itoa(rowcount))
+			logBuf.WriteString(" rows in ")
+			logBuf.WriteString(elapsed.String())
+			logBuf.WriteString(")")
+		}
+		if logBuf.Len() > 0 {
+			switch q.Log.(type) {
+			case *log.Logger:
+				_ = q.Log.Output(q.logSkip+2, logBuf.String())
+			default:
+				_ = q.Log.Output(q.logSkip+1, logBuf.String())
+			}
+		}
+	}()
+	r := newRow(db)
+	q.RowMapper(r)
+	q.SelectFields = r.fields
+	tmpbuf := &strings.Builder{}
+	var tmpargs []interface{}
+	q.logSkip += 1
+	q.AppendSQL(tmpbuf, &tmpargs, nil)
+	if q.LogFunc != nil {
+		logQuery = tmpbuf.String()
+		logArgs = append(logArgs, tmpargs...)
+	}
+	name := "sq_cursor_" + strconv.FormatUint(atomic.AddUint64(&cursorCount, 1), 10)
+	declare := "DECLARE " + name + " CURSOR"
+	if q.CursorWithHold {
+		declare += " WITH HOLD"
+	}
+	declare += " FOR " + tmpbuf.String()
+	fetch := "FETCH FORWARD " + strconv.Itoa(batchSize) + " FROM " + name
+	// mapRow runs the mapper and accumulator on the current row. It reports
+	// whether there should be no more rows mapped.
+	mapRow := func() (done bool, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				switch v := r.(type) {
+				case ExitCode:
+					done = true
+					if v != ExitPeacefully {
+						err = v
+					}
+				case error:
+					err = v
+				default:
+					err = fmt.Errorf("%#v", r)
+				}
+			}
+		}()
+		r.index = 0
+		q.RowMapper(r)
+		if q.Accumulator == nil {
+			return true, nil
+		}
+		q.Accumulator()
+		return false, nil
+	}
+	// fetchBatch fetches the next batch of rows from the cursor. It reports
+	// whether there are no more rows to fetch.
+	fetchBatch := func(db DB) (done bool, err error) {
+		r.rows, err = queryRows(ctx, db, fetch, nil)
+		if err != nil {
+			return true, err
+		}
+		defer r.rows.Close()
+		var n int
+		for r.rows.Next() {
+			n++
+			rowcount++
+			if len(r.dest) > 0 {
+				err = r.rows.Scan(r.dest...)
+				if err != nil {
+					return true, mapperError(r, err)
+				}
+			}
+			if q.Log != nil && Lresults&q.LogFlag != 0 && rowcount <= 5 {
+				logBuf.WriteString("\n----[ Row ")
+				logBuf.WriteString(strconv.Itoa(rowcount))
+				logBuf.WriteString(" ]----")
+				for i := range r.dest {
+					tmpbuf.Reset()
+					tmpargs = tmpargs[:0]
+					r.fields[i].AppendSQLExclude(tmpbuf, &tmpargs, nil, nil)
+					logBuf.WriteString("\n")
+					logBuf.WriteString(dollarInterpolate(tmpbuf.String(), tmpargs...))
+					logBuf.WriteString(": ")
+					logBuf.WriteString(appendSQLDisplay(r.dest[i]))
+				}
+			}
+			done, err = mapRow()
+			if done || err != nil {
+				return true, err
+			}
+		}
+		if e := r.rows.Close(); e != nil {
+			return true, e
+		}
+		return n < batchSize, r.rows.Err()
+	}
+	run := func(db DB) (err error) {
+		_, err = db.ExecContext(ctx, declare, tmpargs...)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err != nil {
+				// use a fresh context in case ctx is what caused the error
+				_, _ = db.ExecContext(context.Background(), "CLOSE "+name)
+			}
+		}()
+		for done := false; !done; {
+			done, err = fetchBatch(db)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = db.ExecContext(ctx, "CLOSE "+name)
+		return err
+	}
+	if !q.CursorWithHold {
+		err = Tx(ctx, db, nil, run)
+	} else if getter, ok := db.(connGetter); ok {
+		var conn *sql.Conn
+		conn, err = getter.Conn(ctx)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		err = run(sqlConn{Conn: conn})
+	} else {
+		err = run(db)
+	}
+	if err != nil {
+		return err
+	}
+	if rowcount == 0 && q.Accumulator == nil {
+		return sql.ErrNoRows
+	}
+	return nil
+}
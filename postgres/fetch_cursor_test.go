@@ -0,0 +1,127 @@
+package sq
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+// recordingDB records the queries run on it. Every query fails with errQuery.
+type recordingDB struct {
+	queries []string
+}
+
+var errQuery = errors.New("query failed")
+
+func (db *recordingDB) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return db.QueryContext(context.Background(), query, args...)
+}
+
+func (db *recordingDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	db.queries = append(db.queries, query)
+	return nil, errQuery
+}
+
+func (db *recordingDB) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return db.ExecContext(context.Background(), query, args...)
+}
+
+func (db *recordingDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	db.queries = append(db.queries, query)
+	return nil, nil
+}
+
+func TestSelectQuery_FetchCursor(t *testing.T) {
+	is := is.New(t)
+	u := USERS()
+	var userID int
+	mapper := func(row *Row) { userID = row.Int(u.USER_ID) }
+
+	// Missing DB
+	err := From(u).SelectRowx(mapper).FetchCursor(nil, nil, 10)
+	is.True(err != nil)
+
+	// No mapper
+	err = From(u).FetchCursor(nil, &recordingDB{}, 10)
+	is.True(err != nil)
+
+	// Invalid batch size
+	err = From(u).SelectRowx(mapper).FetchCursor(nil, &recordingDB{}, 0)
+	is.True(err != nil)
+
+	// A DB that cannot begin a transaction is not a transaction either
+	db := &recordingDB{}
+	err = From(u).SelectRowx(mapper).FetchCursor(nil, db, 100)
+	is.True(err != nil)
+	is.Equal(0, len(db.queries))
+
+	// Statements, and the cursor is closed on error
+	conn := &fakeTxConn{}
+	tx, err := sql.OpenDB(conn).Begin()
+	is.NoErr(err)
+	defer tx.Rollback()
+	err = From(u).Where(u.USER_ID.GtInt(5)).SelectRowx(mapper).FetchCursor(nil, tx, 100)
+	is.True(errors.Is(err, errQuery))
+	is.Equal(5, len(conn.queries))
+	is.True(strings.HasPrefix(conn.queries[0], "SAVEPOINT "))
+	name := strings.Fields(conn.queries[1])[1]
+	is.Equal("DECLARE "+name+" CURSOR FOR SELECT users.user_id FROM public.users WHERE users.user_id > $1", conn.queries[1])
+	is.Equal("FETCH FORWARD 100 FROM "+name, conn.queries[2])
+	is.Equal("CLOSE "+name, conn.queries[3])
+	is.True(strings.HasPrefix(conn.queries[4], "ROLLBACK TO SAVEPOINT "))
+
+	// WITH HOLD does not need a transaction
+	db = &recordingDB{}
+	err = From(u).SelectRowx(mapper).WithHold().FetchCursor(nil, db, 100)
+	is.True(errors.Is(err, errQuery))
+	is.Equal(3, len(db.queries))
+	name = strings.Fields(db.queries[0])[1]
+	is.Equal("DECLARE "+name+" CURSOR WITH HOLD FOR SELECT users.user_id FROM public.users", db.queries[0])
+	is.Equal("CLOSE "+name, db.queries[2])
+
+	if testing.Short() {
+		return
+	}
+	sqlDB, err := sql.Open("txdb", "SelectQuery_FetchCursor")
+	is.NoErr(err)
+	defer sqlDB.Close()
+
+	var want []int
+	err = WithDB(sqlDB).From(u).OrderBy(u.USER_ID).Selectx(mapper, func() {
+		want = append(want, userID)
+	}).Fetch(nil)
+	is.NoErr(err)
+	is.True(len(want) > 10)
+
+	// Batches smaller than, equal to and larger than the result set
+	for _, batchSize := range []int{3, len(want), len(want) + 1} {
+		var got []int
+		err = WithDefaultLog(Lverbose).WithDB(sqlDB).From(u).OrderBy(u.USER_ID).Selectx(mapper, func() {
+			got = append(got, userID)
+		}).FetchCursor(nil, nil, batchSize)
+		is.NoErr(err)
+		is.Equal(want, got)
+	}
+
+	// WITH HOLD
+	var got []int
+	err = WithDB(sqlDB).From(u).OrderBy(u.USER_ID).Selectx(mapper, func() {
+		got = append(got, userID)
+	}).WithHold().FetchCursor(nil, nil, 4)
+	is.NoErr(err)
+	is.Equal(want, got)
+
+	// No accumulator
+	userID = 0
+	err = WithDB(sqlDB).From(u).OrderBy(u.USER_ID).SelectRowx(mapper).FetchCursor(nil, nil, 4)
+	is.NoErr(err)
+	is.Equal(want[0], userID)
+
+	// sql.ErrNoRows
+	err = WithDB(sqlDB).From(u).Where(u.USER_ID.EqInt(-1)).SelectRowx(mapper).FetchCursor(nil, nil, 4)
+	is.True(errors.Is(err, sql.ErrNoRows))
+}
@@ -0,0 +1,112 @@
+package sq
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestGet(t *testing.T) {
+	is := is.New(t)
+	u := USERS()
+	row := &Row{}
+	is.Equal(0, Get[int](row, u.USER_ID))
+	is.Equal("", Get[string](row, u.DISPLAYNAME))
+	is.Equal(sql.NullString{}, Get[sql.NullString](row, u.EMAIL))
+	is.Equal(Fields{u.USER_ID, u.DISPLAYNAME, u.EMAIL}, Fields(row.fields))
+}
+
+func TestFetchSlice(t *testing.T) {
+	is := is.New(t)
+	u := USERS()
+	displayname := func(row *Row) string {
+		return row.String(u.DISPLAYNAME)
+	}
+
+	// Unsupported query
+	_, err := FetchSlice(nil, From(u).Subquery("subquery"), displayname)
+	is.True(err != nil)
+
+	if testing.Short() {
+		return
+	}
+	db, err := sql.Open("txdb", "FetchSlice")
+	is.NoErr(err)
+	defer db.Close()
+
+	// SelectQuery
+	users, err := FetchSlice(db, From(u).Where(u.USER_ID.LeInt(5)).OrderBy(u.USER_ID), func(row *Row) User {
+		var user User
+		user.UserID = Get[int](row, u.USER_ID)
+		user.Displayname = row.String(u.DISPLAYNAME)
+		return user
+	})
+	is.NoErr(err)
+	is.Equal(5, len(users))
+	is.Equal(1, users[0].UserID)
+
+	// Any existing mapper is ignored
+	var ignored int
+	names, err := FetchSlice(db, From(u).
+		Where(u.USER_ID.LeInt(5)).
+		SelectRowx(func(row *Row) { ignored = row.Int(u.USER_ID) }),
+		displayname,
+	)
+	is.NoErr(err)
+	is.Equal(5, len(names))
+	is.Equal(0, ignored)
+
+	// No rows
+	names, err = FetchSlice(db, From(u).Where(u.USER_ID.EqInt(-1)), displayname)
+	is.NoErr(err)
+	is.Equal(0, len(names))
+
+	// VariadicQuery
+	names, err = FetchSlice(db, UnionAll(
+		Select(u.DISPLAYNAME).From(u).Where(u.USER_ID.EqInt(1)),
+		Select(u.DISPLAYNAME).From(u).Where(u.USER_ID.EqInt(2)),
+	), displayname)
+	is.NoErr(err)
+	is.Equal(2, len(names))
+
+	// RETURNING
+	ids, err := FetchSlice(db, Update(u).Set(u.EMAIL.SetString("x")).Where(u.USER_ID.LeInt(3)), func(row *Row) int {
+		return row.Int(u.USER_ID)
+	})
+	is.NoErr(err)
+	is.Equal(3, len(ids))
+}
+
+func TestFetchOne(t *testing.T) {
+	if testing.Short() {
+		return
+	}
+	is := is.New(t)
+	db, err := sql.Open("txdb", "FetchOne")
+	is.NoErr(err)
+	defer db.Close()
+	u := USERS()
+
+	user, err := FetchOne(db, From(u).Where(u.USER_ID.EqInt(1)), func(row *Row) User {
+		var user User
+		user.RowMapper(u)(row)
+		return user
+	})
+	is.NoErr(err)
+	is.Equal(1, user.UserID)
+
+	// No rows
+	_, err = FetchOne(db, From(u).Where(u.USER_ID.EqInt(-1)), func(row *Row) int {
+		return row.Int(u.USER_ID)
+	})
+	is.True(errors.Is(err, sql.ErrNoRows))
+
+	// RETURNING
+	userID, err := FetchOne(db, DeleteFrom(u).Where(u.USER_ID.EqInt(-1)), func(row *Row) int {
+		return row.Int(u.USER_ID)
+	})
+	is.True(errors.Is(err, sql.ErrNoRows))
+	is.Equal(0, userID)
+}
@@ -0,0 +1,77 @@
+package sq
+
+import "strings"
+
+// GenerateSeriesTable is a Table created by GenerateSeries. It has a single
+// timestamp column, which is named by calling the Column method.
+type GenerateSeriesTable struct {
+	alias  string
+	start  interface{}
+	stop   interface{}
+	step   Interval
+	column string
+}
+
+// GenerateSeries creates a new table with one row for every timestamp from
+// start to stop inclusive, stepping by the Interval. The start and stop can be
+// a time.Time or a TimeField. Postgres only allows a column list if the table
+// has an alias, so the alias must not be empty.
+//
+//	days := GenerateSeries("days", start, end, Interval{Days: 1})
+//	day := days.Column("day")
+//	From(days).LeftJoin(o, o.CREATED_AT.DateTrunc("day").Eq(day)).GroupBy(day).Select(day, Count())
+func GenerateSeries(alias string, start, stop interface{}, step Interval) *GenerateSeriesTable {
+	return &GenerateSeriesTable{
+		alias: alias,
+		start: start,
+		stop:  stop,
+		step:  step,
+	}
+}
+
+// AppendSQL marshals the GenerateSeriesTable into a buffer and an args slice.
+// The column list is written after the table alias by the FROM or JOIN clause.
+func (tbl *GenerateSeriesTable) AppendSQL(buf *strings.Builder, args *[]interface{}, params map[string]int) {
+	buf.WriteString("generate_series(")
+	appendSQLValue(buf, args, nil, tbl.start)
+	buf.WriteString(", ")
+	appendSQLValue(buf, args, nil, tbl.stop)
+	buf.WriteString(", INTERVAL ")
+	buf.WriteString(quoteLiteral(tbl.step.String()))
+	buf.WriteString(")")
+}
+
+// appendColumnAliases writes the column list of the GenerateSeriesTable i.e.
+// '(col)'.
+func (tbl *GenerateSeriesTable) appendColumnAliases(buf *strings.Builder) {
+	if tbl.column == "" {
+		return
+	}
+	buf.WriteString("(")
+	if strings.ContainsAny(tbl.column, " \t") {
+		buf.WriteString(`"`)
+		buf.WriteString(tbl.column)
+		buf.WriteString(`"`)
+	} else {
+		buf.WriteString(tbl.column)
+	}
+	buf.WriteString(")")
+}
+
+// GetAlias implements the Table interface. It returns the alias of the
+// GenerateSeriesTable.
+func (tbl *GenerateSeriesTable) GetAlias() string {
+	return tbl.alias
+}
+
+// GetName implements the Table interface. It always returns an empty string,
+// as a GenerateSeriesTable can only be referred to by its alias.
+func (tbl *GenerateSeriesTable) GetName() string {
+	return ""
+}
+
+// Column names the timestamp column and returns it as a TimeField.
+func (tbl *GenerateSeriesTable) Column(name string) TimeField {
+	tbl.column = name
+	return NewTimeField(name, tbl)
+}
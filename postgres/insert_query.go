@@ -41,6 +41,7 @@ type InsertQuery struct {
 	// Logging
 	Log     Logger
 	LogFlag LogFlag
+	LogFunc LogFunc
 	logSkip int
 }
 
@@ -54,6 +55,15 @@ func (q InsertQuery) ToSQL() (query string, args []interface{}) {
 	q.logSkip += 1
 	buf := &strings.Builder{}
 	q.AppendSQL(buf, &args, nil)
+	if q.LogFunc != nil {
+		q.LogFunc(LogInfo{
+			LogFlag: q.LogFlag,
+			LogSkip: q.logSkip + 2,
+			Query:   buf.String(),
+			Args:    args,
+			Action:  LogActionToSQL,
+		})
+	}
 	return buf.String(), args
 }
 
@@ -305,6 +315,25 @@ func (q InsertQuery) Fetch(db DB) (err error) {
 // maps the results based on the mapper function (and optionally runs the
 // accumulator function).
 func (q InsertQuery) FetchContext(ctx context.Context, db DB) (err error) {
+	logBuf := &strings.Builder{}
+	start := time.Now()
+	var rowcount int
+	var logQuery string
+	var logArgs []interface{}
+	if q.LogFunc != nil {
+		defer func() {
+			q.LogFunc(LogInfo{
+				LogFlag:     q.LogFlag,
+				LogSkip:     q.logSkip + 3,
+				Query:       logQuery,
+				Args:        logArgs,
+				Action:      LogActionFetch,
+				TimeTaken:   time.Since(start),
+				Err:         err,
+				RowsFetched: int64(rowcount),
+			})
+		}()
+	}
 	if db == nil {
 		if q.DB == nil {
 			return errors.New("DB cannot be nil")
@@ -314,9 +343,6 @@ func (q InsertQuery) FetchContext(ctx context.Context, db DB) (err error) {
 	if q.RowMapper == nil {
 		return fmt.Errorf("cannot call Fetch/FetchContext without a mapper")
 	}
-	logBuf := &strings.Builder{}
-	start := time.Now()
-	var rowcount int
 	defer func() {
 		if r := recover(); r != nil {
 			switch v := r.(type) {
@@ -354,18 +380,18 @@ func (q InsertQuery) FetchContext(ctx context.Context, db DB) (err error) {
 			}
 		}
 	}()
-	r := &Row{}
+	r := newRow(db)
 	q.RowMapper(r)
 	q.ReturningFields = r.fields
 	tmpbuf := &strings.Builder{}
 	var tmpargs []interface{}
 	q.logSkip += 1
 	q.AppendSQL(tmpbuf, &tmpargs, nil)
-	if ctx == nil {
-		r.rows, err = db.Query(tmpbuf.String(), tmpargs...)
-	} else {
-		r.rows, err = db.QueryContext(ctx, tmpbuf.String(), tmpargs...)
+	if q.LogFunc != nil {
+		logQuery = tmpbuf.String()
+		logArgs = append(logArgs, tmpargs...)
 	}
+	r.rows, err = queryRows(ctx, db, tmpbuf.String(), tmpargs)
 	if err != nil {
 		return err
 	}
@@ -429,14 +455,31 @@ func (q InsertQuery) Exec(db DB, flag ExecFlag) (rowsAffected int64, err error)
 // ExecContext will execute the InsertQuery with the given DB and context. It will
 // only compute the rowsAffected if the ErowsAffected Execflag is passed to it.
 func (q InsertQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (rowsAffected int64, err error) {
+	logBuf := &strings.Builder{}
+	start := time.Now()
+	var logQuery string
+	var logArgs []interface{}
+	if q.LogFunc != nil {
+		defer func() {
+			q.LogFunc(LogInfo{
+				LogFlag:      q.LogFlag,
+				LogSkip:      q.logSkip + 3,
+				Query:        logQuery,
+				Args:         logArgs,
+				Action:       LogActionExec,
+				TimeTaken:    time.Since(start),
+				Err:          err,
+				ExecFlag:     flag,
+				RowsAffected: rowsAffected,
+			})
+		}()
+	}
 	if db == nil {
 		if q.DB == nil {
 			return rowsAffected, errors.New("DB cannot be nil")
 		}
 		db = q.DB
 	}
-	logBuf := &strings.Builder{}
-	start := time.Now()
 	defer func() {
 		if r := recover(); r != nil {
 			switch v := r.(type) {
@@ -472,6 +515,9 @@ func (q InsertQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (row
 	var tmpargs []interface{}
 	q.logSkip += 1
 	q.AppendSQL(tmpbuf, &tmpargs, nil)
+	if q.LogFunc != nil {
+		logQuery, logArgs = tmpbuf.String(), tmpargs
+	}
 	if ctx == nil {
 		res, err = db.Exec(tmpbuf.String(), tmpargs...)
 	} else {
@@ -489,6 +535,134 @@ func (q InsertQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (row
 	return rowsAffected, nil
 }
 
+// batches splits the InsertQuery into InsertQueries of at most batchSize rows
+// each. An InsertQuery without VALUES (e.g. INSERT ... SELECT) is not split.
+func (q InsertQuery) batches(batchSize int) (batches []InsertQuery, err error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("batchSize must be positive, got %d", batchSize)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			switch v := r.(type) {
+			case error:
+				err = v
+			default:
+				err = fmt.Errorf("%#v", r)
+			}
+		}
+	}()
+	if q.ColumnMapper != nil {
+		col := &Column{mode: colmodeInsert}
+		q.ColumnMapper(col)
+		q.InsertColumns = col.insertColumns
+		q.RowValues = col.rowValues
+		q.ColumnMapper = nil
+	}
+	if len(q.RowValues) <= batchSize {
+		return []InsertQuery{q}, nil
+	}
+	rowValues := q.RowValues
+	for len(rowValues) > 0 {
+		n := batchSize
+		if n > len(rowValues) {
+			n = len(rowValues)
+		}
+		q.RowValues = rowValues[:n:n]
+		batches = append(batches, q)
+		rowValues = rowValues[n:]
+	}
+	return batches, nil
+}
+
+// FetchBatched is like Fetch, but splits the rows of the InsertQuery into
+// several INSERT statements of at most batchSize rows each (see ExecBatched).
+// With an accumulator, the mapper and accumulator are run for the rows
+// returned by every statement. Without one, the mapper is only run for the
+// first row that is returned, the same as Fetch, and the rest of the
+// statements are only executed. Call FetchBatched inside Tx to run all of the
+// statements in one transaction.
+func (q InsertQuery) FetchBatched(db DB, batchSize int) (err error) {
+	q.logSkip += 1
+	return q.FetchBatchedContext(nil, db, batchSize)
+}
+
+// FetchBatchedContext is like FetchBatched, but runs every statement with the
+// given context.
+func (q InsertQuery) FetchBatchedContext(ctx context.Context, db DB, batchSize int) (err error) {
+	batches, err := q.batches(batchSize)
+	if err != nil {
+		return err
+	}
+	var fetched bool
+	for _, batch := range batches {
+		batch.logSkip += 1
+		if fetched && q.Accumulator == nil {
+			// the mapper already has the first row, so running it again would
+			// overwrite that row with the first row of this batch
+			_, err = batch.ExecContext(ctx, db, 0)
+		} else {
+			err = batch.FetchContext(ctx, db)
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		fetched = true
+	}
+	if !fetched && q.Accumulator == nil {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// ExecBatched is like Exec, but splits the rows of the InsertQuery into
+// several INSERT statements of at most batchSize rows each, which keeps large
+// inserts under the Postgres limit of 65535 bind parameters per statement.
+// The rowsAffected of every statement are added together. If the Etransaction
+// ExecFlag is passed, all of the statements are run inside one transaction
+// (or a savepoint, if db is already a transaction).
+func (q InsertQuery) ExecBatched(db DB, batchSize int, flag ExecFlag) (rowsAffected int64, err error) {
+	q.logSkip += 1
+	return q.ExecBatchedContext(nil, db, batchSize, flag)
+}
+
+// ExecBatchedContext is like ExecBatched, but runs every statement with the
+// given context.
+func (q InsertQuery) ExecBatchedContext(ctx context.Context, db DB, batchSize int, flag ExecFlag) (rowsAffected int64, err error) {
+	if db == nil {
+		if q.DB == nil {
+			return rowsAffected, errors.New("DB cannot be nil")
+		}
+		db = q.DB
+	}
+	batches, err := q.batches(batchSize)
+	if err != nil {
+		return rowsAffected, err
+	}
+	logSkip := 2
+	execBatches := func(db DB) error {
+		rowsAffected = 0
+		for _, batch := range batches {
+			batch.logSkip += logSkip
+			n, err := batch.ExecContext(ctx, db, flag)
+			rowsAffected += n
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if Etransaction&flag == 0 {
+		err = execBatches(db)
+		return rowsAffected, err
+	}
+	logSkip += 2
+	err = Tx(ctx, db, nil, execBatches)
+	return rowsAffected, err
+}
+
 // NestThis indicates to the InsertQuery that it is nested.
 func (q InsertQuery) NestThis() Query {
 	q.nested = true
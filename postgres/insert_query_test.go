@@ -525,3 +525,145 @@ func TestInsertQuery_Exec(t *testing.T) {
 	is.NoErr(err)
 	is.Equal(int64(0), rowsAffected)
 }
+
+func TestInsertQuery_batches(t *testing.T) {
+	is := is.New(t)
+	u := USERS()
+	names := []string{"aaa", "bbb", "ccc", "ddd", "eee"}
+	q := InsertInto(u).Valuesx(func(col *Column) {
+		for _, name := range names {
+			col.SetString(u.DISPLAYNAME, name)
+			col.SetString(u.EMAIL, name+"@email.com")
+		}
+	})
+
+	batches, err := q.batches(2)
+	is.NoErr(err)
+	is.Equal(3, len(batches))
+	gotQuery, gotArgs := batches[0].ToSQL()
+	is.Equal("INSERT INTO public.users (displayname, email) VALUES ($1, $2), ($3, $4)", gotQuery)
+	is.Equal([]interface{}{"aaa", "aaa@email.com", "bbb", "bbb@email.com"}, gotArgs)
+	gotQuery, gotArgs = batches[2].ToSQL()
+	is.Equal("INSERT INTO public.users (displayname, email) VALUES ($1, $2)", gotQuery)
+	is.Equal([]interface{}{"eee", "eee@email.com"}, gotArgs)
+
+	// Fewer rows than batchSize
+	batches, err = q.batches(10)
+	is.NoErr(err)
+	is.Equal(1, len(batches))
+
+	// INSERT ... SELECT is not split
+	batches, err = InsertInto(u).Columns(u.DISPLAYNAME).Select(Select(u.EMAIL).From(u)).batches(1)
+	is.NoErr(err)
+	is.Equal(1, len(batches))
+
+	// Invalid batchSize
+	_, err = q.batches(0)
+	is.True(err != nil)
+
+	// Panicking ColumnMapper
+	_, err = InsertInto(u).Valuesx(func(col *Column) { panic("oops") }).batches(1)
+	is.True(err != nil)
+}
+
+func TestInsertQuery_ExecBatched(t *testing.T) {
+	if testing.Short() {
+		return
+	}
+	is := is.New(t)
+	db, err := sql.Open("txdb", "InsertQuery_ExecBatched")
+	is.NoErr(err)
+	defer db.Close()
+	u := USERS()
+	countUsers := func() int {
+		var count int
+		err := WithDB(db).From(u).SelectRowx(func(row *Row) {
+			row.ScanInto(&count, Count())
+		}).Fetch(nil)
+		is.NoErr(err)
+		return count
+	}
+	insertUsers := func(names ...string) InsertQuery {
+		return WithDB(db).InsertInto(u).Valuesx(func(col *Column) {
+			for _, name := range names {
+				col.SetString(u.DISPLAYNAME, name)
+				col.SetString(u.EMAIL, name+"@batched.com")
+			}
+		})
+	}
+	count := countUsers()
+
+	// Missing DB
+	_, err = InsertInto(u).Valuesx(func(col *Column) {}).ExecBatched(nil, 1, 0)
+	is.True(err != nil)
+
+	// rowsAffected is aggregated
+	rowsAffected, err := insertUsers("a1", "a2", "a3", "a4", "a5").ExecBatched(nil, 2, ErowsAffected)
+	is.NoErr(err)
+	is.Equal(int64(5), rowsAffected)
+	is.Equal(count+5, countUsers())
+
+	// Etransaction
+	rowsAffected, err = insertUsers("d1", "d2", "d3").ExecBatched(nil, 2, ErowsAffected|Etransaction)
+	is.NoErr(err)
+	is.Equal(int64(3), rowsAffected)
+	is.Equal(count+8, countUsers())
+}
+
+func TestInsertQuery_FetchBatched(t *testing.T) {
+	if testing.Short() {
+		return
+	}
+	is := is.New(t)
+	db, err := sql.Open("txdb", "InsertQuery_FetchBatched")
+	is.NoErr(err)
+	defer db.Close()
+	u := USERS()
+	names := []string{"aaa", "bbb", "ccc", "ddd", "eee"}
+	q := WithDB(db).InsertInto(u).Valuesx(func(col *Column) {
+		for _, name := range names {
+			col.SetString(u.DISPLAYNAME, name)
+			col.SetString(u.EMAIL, name+"@batched.com")
+		}
+	})
+
+	// Returned rows are aggregated
+	var email string
+	var emails []string
+	err = q.Returningx(func(row *Row) {
+		email = row.String(u.EMAIL)
+	}, func() {
+		emails = append(emails, email)
+	}).FetchBatched(nil, 2)
+	is.NoErr(err)
+	is.Equal([]string{"aaa@batched.com", "bbb@batched.com", "ccc@batched.com", "ddd@batched.com", "eee@batched.com"}, emails)
+
+	// Without an accumulator, the first row of the insert is kept and every
+	// row is still inserted
+	err = WithDB(db).InsertInto(u).Valuesx(func(col *Column) {
+		for _, name := range []string{"fff", "ggg", "hhh", "iii", "jjj"} {
+			col.SetString(u.DISPLAYNAME, name)
+			col.SetString(u.EMAIL, name+"@batched.com")
+		}
+	}).ReturningRowx(func(row *Row) {
+		email = row.String(u.EMAIL)
+	}).FetchBatched(nil, 2)
+	is.NoErr(err)
+	is.Equal("fff@batched.com", email)
+	var count int
+	err = From(u).Where(u.EMAIL.LikeString("%@batched.com")).SelectRowx(func(row *Row) {
+		count = row.Int(Count())
+	}).Fetch(db)
+	is.NoErr(err)
+	is.Equal(10, count)
+
+	// sql.ErrNoRows
+	err = q.OnConflict().DoNothing().ReturningRowx(func(row *Row) {
+		email = row.String(u.EMAIL)
+	}).FetchBatched(nil, 2)
+	is.True(errors.Is(err, sql.ErrNoRows))
+
+	// Invalid batchSize
+	err = q.ReturningRowx(func(row *Row) {}).FetchBatched(nil, 0)
+	is.True(err != nil)
+}
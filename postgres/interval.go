@@ -0,0 +1,232 @@
+package sq
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Interval is a Postgres interval made up of calendar units and a
+// time.Duration. The calendar units are kept separate from the Duration
+// because the length of a month or a day depends on the date it is added to
+// (a day can be 23 or 25 hours long across a daylight saving time change).
+type Interval struct {
+	Years    int
+	Months   int
+	Days     int
+	Duration time.Duration
+}
+
+// String returns the Interval in the same format that Postgres outputs
+// intervals in e.g. '1 year 2 mons 3 days 04:05:06.5'.
+func (i Interval) String() string {
+	buf := &strings.Builder{}
+	appendIntervalUnit(buf, i.Years, "year", "years")
+	appendIntervalUnit(buf, i.Months, "mon", "mons")
+	appendIntervalUnit(buf, i.Days, "day", "days")
+	if i.Duration != 0 || buf.Len() == 0 {
+		if buf.Len() > 0 {
+			buf.WriteString(" ")
+		}
+		d := i.Duration
+		if d < 0 {
+			buf.WriteString("-")
+			d = -d
+		}
+		hours := d / time.Hour
+		minutes := d % time.Hour / time.Minute
+		seconds := d % time.Minute / time.Second
+		microseconds := d % time.Second / time.Microsecond
+		buf.WriteString(pad2(int64(hours)))
+		buf.WriteString(":")
+		buf.WriteString(pad2(int64(minutes)))
+		buf.WriteString(":")
+		buf.WriteString(pad2(int64(seconds)))
+		if microseconds > 0 {
+			fraction := strconv.FormatInt(int64(microseconds)+1000000, 10)[1:]
+			buf.WriteString(".")
+			buf.WriteString(strings.TrimRight(fraction, "0"))
+		}
+	}
+	return buf.String()
+}
+
+// Value implements the driver.Valuer interface.
+func (i Interval) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+// Scan implements the sql.Scanner interface. It parses intervals in the
+// 'postgres' and 'postgres_verbose' IntervalStyles e.g. '1 year 2 mons 3 days
+// 04:05:06.5' or '@ 1 year 2 mons 3 days 4 hours 5 mins 6.5 secs ago'.
+func (i *Interval) Scan(src interface{}) error {
+	var s string
+	switch src := src.(type) {
+	case nil:
+		*i = Interval{}
+		return nil
+	case []byte:
+		s = string(src)
+	case string:
+		s = src
+	default:
+		return fmt.Errorf("cannot scan %T into Interval", src)
+	}
+	interval, err := parseInterval(s)
+	if err != nil {
+		return err
+	}
+	*i = interval
+	return nil
+}
+
+// TotalDuration converts the whole Interval into a time.Duration the same way
+// Postgres computes the epoch of an interval: a day is 24 hours, a month is 30
+// days and a year is 365.25 days.
+func (i Interval) TotalDuration() time.Duration {
+	const day = 24 * time.Hour
+	return time.Duration(i.Years)*(365*day+day/4) +
+		time.Duration(i.Months)*30*day +
+		time.Duration(i.Days)*day +
+		i.Duration
+}
+
+// parseInterval parses the Postgres text representation of an interval.
+func parseInterval(s string) (Interval, error) {
+	var interval Interval
+	fields := strings.Fields(s)
+	if len(fields) > 0 && fields[0] == "@" {
+		fields = fields[1:]
+	}
+	ago := false
+	if len(fields) > 0 && fields[len(fields)-1] == "ago" {
+		ago = true
+		fields = fields[:len(fields)-1]
+	}
+	if len(fields) == 0 {
+		return Interval{}, fmt.Errorf("invalid interval %q", s)
+	}
+	for len(fields) > 0 {
+		field := fields[0]
+		fields = fields[1:]
+		if strings.Contains(field, ":") {
+			d, err := parseIntervalTime(field)
+			if err != nil {
+				return Interval{}, fmt.Errorf("invalid interval %q: %w", s, err)
+			}
+			interval.Duration += d
+			continue
+		}
+		if len(fields) == 0 {
+			return Interval{}, fmt.Errorf("invalid interval %q: %s has no unit", s, field)
+		}
+		unitName := fields[0]
+		fields = fields[1:]
+		unit := strings.TrimSuffix(strings.ToLower(unitName), "s")
+		switch unit {
+		case "year", "yr", "mon", "month", "week", "day":
+			n, err := strconv.Atoi(field)
+			if err != nil {
+				return Interval{}, fmt.Errorf("invalid interval %q: %w", s, err)
+			}
+			switch unit {
+			case "year", "yr":
+				interval.Years += n
+			case "mon", "month":
+				interval.Months += n
+			case "week":
+				interval.Days += 7 * n
+			case "day":
+				interval.Days += n
+			}
+		case "hour", "hr", "min", "minute", "sec", "second", "millisecond", "microsecond":
+			n, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				return Interval{}, fmt.Errorf("invalid interval %q: %w", s, err)
+			}
+			var unitDuration time.Duration
+			switch unit {
+			case "hour", "hr":
+				unitDuration = time.Hour
+			case "min", "minute":
+				unitDuration = time.Minute
+			case "sec", "second":
+				unitDuration = time.Second
+			case "millisecond":
+				unitDuration = time.Millisecond
+			case "microsecond":
+				unitDuration = time.Microsecond
+			}
+			interval.Duration += time.Duration(math.Round(n*float64(unitDuration/time.Microsecond))) * time.Microsecond
+		default:
+			return Interval{}, fmt.Errorf("invalid interval %q: unknown unit %s", s, unitName)
+		}
+	}
+	if ago {
+		interval.Years, interval.Months, interval.Days = -interval.Years, -interval.Months, -interval.Days
+		interval.Duration = -interval.Duration
+	}
+	return interval, nil
+}
+
+// parseIntervalTime parses the '[+-]hh:mm:ss[.ffffff]' part of an interval.
+func parseIntervalTime(s string) (time.Duration, error) {
+	negative := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		negative = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	hours, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	minutes, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	d := time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute
+	if len(parts) == 3 {
+		seconds, err := strconv.ParseFloat(parts[2], 64)
+		if err != nil {
+			return 0, err
+		}
+		d += time.Duration(math.Round(seconds*1e6)) * time.Microsecond
+	}
+	if negative {
+		d = -d
+	}
+	return d, nil
+}
+
+func appendIntervalUnit(buf *strings.Builder, n int, singular, plural string) {
+	if n == 0 {
+		return
+	}
+	if buf.Len() > 0 {
+		buf.WriteString(" ")
+	}
+	buf.WriteString(strconv.Itoa(n))
+	buf.WriteString(" ")
+	if n == 1 || n == -1 {
+		buf.WriteString(singular)
+	} else {
+		buf.WriteString(plural)
+	}
+}
+
+func pad2(n int64) string {
+	if n < 10 {
+		return "0" + strconv.FormatInt(n, 10)
+	}
+	return strconv.FormatInt(n, 10)
+}
@@ -0,0 +1,342 @@
+package sq
+
+import (
+	"strings"
+	"time"
+)
+
+// IntervalField either represents an interval column, an interval expression
+// or a literal Interval value.
+type IntervalField struct {
+	// IntervalField will be one of the following:
+
+	// 1) Interval expression
+	// Examples of interval expressions:
+	// | query                                   | args |
+	// |-----------------------------------------|------|
+	// | age(users.updated_at, users.created_at) |      |
+	// | events.end_at - events.start_at         |      |
+	format *string
+	values []interface{}
+
+	// 2) Literal Interval value
+	// Examples of literal Interval values:
+	// | query                | args       |
+	// |----------------------|------------|
+	// | CAST(? AS INTERVAL)  | Interval{} |
+	value *Interval
+
+	// 3) Interval column
+	// Examples of interval columns:
+	// | query                | args |
+	// |----------------------|------|
+	// | tasks.time_spent     |      |
+	// | time_spent           |      |
+	// | plans.billing_period |      |
+	alias      string
+	table      Table
+	name       string
+	descending *bool
+	nullsfirst *bool
+}
+
+// AppendSQLExclude marshals the IntervalField into an SQL query and args as
+// described in the IntervalField internal struct comments.
+func (f IntervalField) AppendSQLExclude(buf *strings.Builder, args *[]interface{}, params map[string]int, excludedTableQualifiers []string) {
+	switch {
+	case f.format != nil:
+		// 1) Interval expression
+		expandValues(buf, args, excludedTableQualifiers, *f.format, f.values)
+	case f.value != nil:
+		// 2) Literal Interval value
+		buf.WriteString("CAST(? AS INTERVAL)")
+		*args = append(*args, *f.value)
+	default:
+		// 3) Interval column
+		tableQualifier := f.table.GetAlias()
+		if tableQualifier == "" {
+			tableQualifier = f.table.GetName()
+		}
+		for _, excludedTableQualifier := range excludedTableQualifiers {
+			if tableQualifier == excludedTableQualifier {
+				tableQualifier = ""
+				break
+			}
+		}
+		if tableQualifier != "" {
+			if strings.ContainsAny(tableQualifier, " \t") {
+				buf.WriteString(`"`)
+				buf.WriteString(tableQualifier)
+				buf.WriteString(`".`)
+			} else {
+				buf.WriteString(tableQualifier)
+				buf.WriteString(".")
+			}
+		}
+		if strings.ContainsAny(f.name, " \t") {
+			buf.WriteString(`"`)
+			buf.WriteString(f.name)
+			buf.WriteString(`"`)
+		} else {
+			buf.WriteString(f.name)
+		}
+	}
+	if f.descending != nil {
+		if *f.descending {
+			buf.WriteString(" DESC")
+		} else {
+			buf.WriteString(" ASC")
+		}
+	}
+	if f.nullsfirst != nil {
+		if *f.nullsfirst {
+			buf.WriteString(" NULLS FIRST")
+		} else {
+			buf.WriteString(" NULLS LAST")
+		}
+	}
+}
+
+// NewIntervalField returns a new IntervalField representing an interval
+// column.
+func NewIntervalField(name string, table Table) IntervalField {
+	return IntervalField{
+		name:  name,
+		table: table,
+	}
+}
+
+// IntervalValue returns a new IntervalField representing a literal Interval
+// value.
+func IntervalValue(interval Interval) IntervalField {
+	return IntervalField{
+		value: &interval,
+	}
+}
+
+// IntervalDuration returns a new IntervalField representing a literal
+// time.Duration e.g. IntervalDuration(90 * time.Minute) is '01:30:00'.
+func IntervalDuration(d time.Duration) IntervalField {
+	return IntervalValue(Interval{Duration: d})
+}
+
+// IntervalParts returns a new IntervalField representing a literal interval of
+// calendar units e.g. IntervalParts(1, 2, 3) is '1 year 2 mons 3 days'.
+func IntervalParts(years, months, days int) IntervalField {
+	return IntervalValue(Interval{Years: years, Months: months, Days: days})
+}
+
+// operand returns the format to use for the IntervalField on the right side of
+// an operator, wrapping interval expressions in brackets.
+func (f IntervalField) operand() string {
+	if f.format != nil {
+		return "(?)"
+	}
+	return "?"
+}
+
+// Set returns a FieldAssignment associating the IntervalField to the value
+// i.e. 'field = value'.
+func (f IntervalField) Set(value interface{}) FieldAssignment {
+	return FieldAssignment{
+		Field: f,
+		Value: value,
+	}
+}
+
+// SetInterval returns a FieldAssignment associating the IntervalField to the
+// Interval value i.e. 'field = value'.
+func (f IntervalField) SetInterval(value Interval) FieldAssignment {
+	return FieldAssignment{
+		Field: f,
+		Value: IntervalValue(value),
+	}
+}
+
+// As returns a new IntervalField with the new field Alias i.e. 'field AS
+// Alias'.
+func (f IntervalField) As(alias string) IntervalField {
+	f.alias = alias
+	return f
+}
+
+// Asc returns a new IntervalField indicating that it should be ordered in
+// ascending order i.e. 'ORDER BY field ASC'.
+func (f IntervalField) Asc() IntervalField {
+	desc := false
+	f.descending = &desc
+	return f
+}
+
+// Desc returns a new IntervalField indicating that it should be ordered in
+// descending order i.e. 'ORDER BY field DESC'.
+func (f IntervalField) Desc() IntervalField {
+	desc := true
+	f.descending = &desc
+	return f
+}
+
+// NullsFirst returns a new IntervalField indicating that it should be ordered
+// with nulls first i.e. 'ORDER BY field NULLS FIRST'.
+func (f IntervalField) NullsFirst() IntervalField {
+	nullsfirst := true
+	f.nullsfirst = &nullsfirst
+	return f
+}
+
+// NullsLast returns a new IntervalField indicating that it should be ordered
+// with nulls last i.e. 'ORDER BY field NULLS LAST'.
+func (f IntervalField) NullsLast() IntervalField {
+	nullsfirst := false
+	f.nullsfirst = &nullsfirst
+	return f
+}
+
+// ordering implements the orderedField interface.
+func (f IntervalField) ordering() (field Field, descending, nullsfirst *bool) {
+	descending, nullsfirst = f.descending, f.nullsfirst
+	f.descending, f.nullsfirst = nil, nil
+	return f, descending, nullsfirst
+}
+
+// IsNull returns an 'X IS NULL' Predicate.
+func (f IntervalField) IsNull() Predicate {
+	return CustomPredicate{
+		Format: "? IS NULL",
+		Values: []interface{}{f},
+	}
+}
+
+// IsNotNull returns an 'X IS NOT NULL' Predicate.
+func (f IntervalField) IsNotNull() Predicate {
+	return CustomPredicate{
+		Format: "? IS NOT NULL",
+		Values: []interface{}{f},
+	}
+}
+
+// Eq returns an 'X = Y' Predicate. Postgres compares intervals by their total
+// length, so '1 mon' is equal to '30 days'.
+func (f IntervalField) Eq(field IntervalField) Predicate {
+	return CustomPredicate{
+		Format: "? = ?",
+		Values: []interface{}{f, field},
+	}
+}
+
+// Ne returns an 'X <> Y' Predicate.
+func (f IntervalField) Ne(field IntervalField) Predicate {
+	return CustomPredicate{
+		Format: "? <> ?",
+		Values: []interface{}{f, field},
+	}
+}
+
+// Gt returns an 'X > Y' Predicate.
+func (f IntervalField) Gt(field IntervalField) Predicate {
+	return CustomPredicate{
+		Format: "? > ?",
+		Values: []interface{}{f, field},
+	}
+}
+
+// Ge returns an 'X >= Y' Predicate.
+func (f IntervalField) Ge(field IntervalField) Predicate {
+	return CustomPredicate{
+		Format: "? >= ?",
+		Values: []interface{}{f, field},
+	}
+}
+
+// Lt returns an 'X < Y' Predicate.
+func (f IntervalField) Lt(field IntervalField) Predicate {
+	return CustomPredicate{
+		Format: "? < ?",
+		Values: []interface{}{f, field},
+	}
+}
+
+// Le returns an 'X <= Y' Predicate.
+func (f IntervalField) Le(field IntervalField) Predicate {
+	return CustomPredicate{
+		Format: "? <= ?",
+		Values: []interface{}{f, field},
+	}
+}
+
+// Add returns a new IntervalField that is the sum of the IntervalFields i.e.
+// 'field + other'.
+func (f IntervalField) Add(other IntervalField) IntervalField {
+	format := "? + " + other.operand()
+	return IntervalField{
+		format: &format,
+		values: []interface{}{f, other},
+	}
+}
+
+// Sub returns a new IntervalField that is the difference of the IntervalFields
+// i.e. 'field - other'.
+func (f IntervalField) Sub(other IntervalField) IntervalField {
+	format := "? - " + other.operand()
+	return IntervalField{
+		format: &format,
+		values: []interface{}{f, other},
+	}
+}
+
+// Mul returns a new IntervalField that is the IntervalField multiplied by the
+// number i.e. 'field * n'. The number may be a Go number or a NumberField,
+// which is bracketed if it is an arithmetic expression e.g. 'field * (a + b)'.
+func (f IntervalField) Mul(n interface{}) IntervalField {
+	right := "?"
+	switch v := n.(type) {
+	case NumberField:
+		if v.operator >= operatorMultiplicative {
+			right = "(?)"
+		}
+	case Field:
+		// any other expression could be bound looser than *
+		right = "(?)"
+	}
+	format := f.operand() + " * " + right
+	return IntervalField{
+		format: &format,
+		values: []interface{}{f, n},
+	}
+}
+
+// Extract returns a subfield of the IntervalField e.g. 'epoch', 'day', 'hour'
+// as a NumberField i.e. 'EXTRACT(EPOCH FROM field)'.
+func (f IntervalField) Extract(field string) NumberField {
+	var format string
+	if isIdentifier(field) {
+		format = "EXTRACT(" + strings.ToUpper(field) + " FROM ?)"
+	} else {
+		format = "EXTRACT(" + quoteLiteral(field) + " FROM ?)"
+	}
+	return NumberField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// String implements the fmt.Stringer interface. It returns the string
+// representation of an IntervalField.
+func (f IntervalField) String() string {
+	buf := &strings.Builder{}
+	var args []interface{}
+	f.AppendSQLExclude(buf, &args, nil, nil)
+	return questionInterpolate(buf.String(), args...)
+}
+
+// GetAlias implements the Field interface. It returns the Alias of the
+// IntervalField.
+func (f IntervalField) GetAlias() string {
+	return f.alias
+}
+
+// GetName implements the Field interface. It returns the Name of the
+// IntervalField.
+func (f IntervalField) GetName() string {
+	return f.name
+}
@@ -0,0 +1,129 @@
+package sq
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestIntervalField_AppendSQLExclude(t *testing.T) {
+	type TT struct {
+		description string
+		f           Field
+		exclude     []string
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	tbl := &TableInfo{Schema: "public", Name: "tasks"}
+	timeSpent := NewIntervalField("time spent", tbl)
+	startAt := NewTimeField("start_at", tbl)
+	endAt := NewTimeField("end_at", tbl)
+	tests := []TT{
+		{"column", timeSpent, nil, `tasks."time spent"`, nil},
+		{"excluded", timeSpent.Desc().NullsFirst(), []string{"tasks"}, `"time spent" DESC NULLS FIRST`, nil},
+		{"IntervalDuration", IntervalDuration(90 * time.Minute), nil, "CAST(? AS INTERVAL)", []interface{}{Interval{Duration: 90 * time.Minute}}},
+		{"IntervalParts", IntervalParts(1, 2, 3), nil, "CAST(? AS INTERVAL)", []interface{}{Interval{Years: 1, Months: 2, Days: 3}}},
+		{"Add", timeSpent.Add(IntervalDuration(time.Hour)), nil, `tasks."time spent" + CAST(? AS INTERVAL)`, []interface{}{Interval{Duration: time.Hour}}},
+		{
+			"Sub expression",
+			timeSpent.Sub(endAt.SubTime(startAt)),
+			nil,
+			`tasks."time spent" - (tasks.end_at - tasks.start_at)`,
+			nil,
+		},
+		{"Mul", endAt.SubTime(startAt).Mul(2), nil, "(tasks.end_at - tasks.start_at) * ?", []interface{}{2}},
+		{"Mul column", timeSpent.Mul(NewNumberField("a", tbl)), nil, `tasks."time spent" * tasks.a`, nil},
+		{
+			"Mul expression",
+			timeSpent.Mul(NewNumberField("a", tbl).Add(NewNumberField("b", tbl))),
+			nil,
+			`tasks."time spent" * (tasks.a + tasks.b)`,
+			nil,
+		},
+		{"Extract", timeSpent.Extract("epoch"), nil, `EXTRACT(EPOCH FROM tasks."time spent")`, nil},
+		{"TimeField Add", startAt.Add(timeSpent), nil, `tasks.start_at + tasks."time spent"`, nil},
+		{"TimeField Sub", endAt.Sub(IntervalParts(0, 0, 1)), nil, "tasks.end_at - CAST(? AS INTERVAL)", []interface{}{Interval{Days: 1}}},
+		{
+			"TimeField SubTime expression",
+			endAt.SubTime(startAt.AddInterval(Interval{Days: 1})),
+			nil,
+			"tasks.end_at - (tasks.start_at + INTERVAL '1 day')",
+			nil,
+		},
+		{"TimeField Age", endAt.Age(startAt), nil, "age(tasks.end_at, tasks.start_at)", nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.f.AppendSQLExclude(buf, &args, nil, tt.exclude)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
+
+func TestIntervalField_Predicates(t *testing.T) {
+	type TT struct {
+		description string
+		predicate   Predicate
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	tbl := &TableInfo{Schema: "public", Name: "tasks"}
+	timeSpent := NewIntervalField("time_spent", tbl)
+	estimate := NewIntervalField("estimate", tbl)
+	tests := []TT{
+		{"IsNull", timeSpent.IsNull(), "tasks.time_spent IS NULL", nil},
+		{"IsNotNull", timeSpent.IsNotNull(), "tasks.time_spent IS NOT NULL", nil},
+		{"Eq", timeSpent.Eq(estimate), "tasks.time_spent = tasks.estimate", nil},
+		{"Ne", timeSpent.Ne(estimate), "tasks.time_spent <> tasks.estimate", nil},
+		{"Gt", timeSpent.Gt(IntervalDuration(time.Hour)), "tasks.time_spent > CAST(? AS INTERVAL)", []interface{}{Interval{Duration: time.Hour}}},
+		{"Ge", timeSpent.Ge(estimate), "tasks.time_spent >= tasks.estimate", nil},
+		{"Lt", timeSpent.Lt(estimate), "tasks.time_spent < tasks.estimate", nil},
+		{"Le", timeSpent.Le(estimate), "tasks.time_spent <= tasks.estimate", nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.predicate.AppendSQLExclude(buf, &args, nil, nil)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
+
+func TestRow_Interval(t *testing.T) {
+	if testing.Short() {
+		return
+	}
+	is := is.New(t)
+	db, err := sql.Open("txdb", "Row_Interval")
+	is.NoErr(err)
+	defer db.Close()
+	start := Time(time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC))
+	end := Time(time.Date(2021, 3, 12, 12, 30, 0, 0, time.UTC))
+	var age, elapsed, literal Interval
+	var d time.Duration
+	err = SelectRowx(func(row *Row) {
+		age = row.Interval(end.Age(start))
+		elapsed = row.Interval(end.SubTime(start))
+		literal = row.Interval(IntervalValue(Interval{Years: 1, Months: -2, Days: 3, Duration: -90 * time.Minute}))
+		d = row.Duration(IntervalDuration(90 * time.Second))
+	}).Fetch(db)
+	is.NoErr(err)
+	is.Equal(Interval{Years: 1, Months: 2, Days: 2, Duration: 12*time.Hour + 30*time.Minute}, age)
+	is.Equal(Interval{Days: 427, Duration: 12*time.Hour + 30*time.Minute}, elapsed)
+	is.Equal(Interval{Years: 1, Months: -2, Days: 3, Duration: -90 * time.Minute}, literal)
+	is.Equal(90*time.Second, d)
+}
@@ -0,0 +1,53 @@
+package sq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestInterval_String(t *testing.T) {
+	is := is.New(t)
+	is.Equal("00:00:00", Interval{}.String())
+	is.Equal("1 year", Interval{Years: 1}.String())
+	is.Equal("-2 years 1 mon -3 days", Interval{Years: -2, Months: 1, Days: -3}.String())
+	is.Equal("1 day 25:00:00", Interval{Days: 1, Duration: 25 * time.Hour}.String())
+	is.Equal("00:00:00.000001", Interval{Duration: time.Microsecond}.String())
+	is.Equal("-00:01:01.25", Interval{Duration: -61250 * time.Millisecond}.String())
+}
+
+func TestInterval_Scan(t *testing.T) {
+	type TT struct {
+		src  interface{}
+		want Interval
+	}
+	tests := []TT{
+		{nil, Interval{}},
+		{"00:00:00", Interval{}},
+		{"1 year 2 mons 3 days 04:05:06.5", Interval{Years: 1, Months: 2, Days: 3, Duration: 4*time.Hour + 5*time.Minute + 6500*time.Millisecond}},
+		{[]byte("-1 years -2 mons +3 days -04:05:06"), Interval{Years: -1, Months: -2, Days: 3, Duration: -(4*time.Hour + 5*time.Minute + 6*time.Second)}},
+		{"14 mon 3 day 00:00:00.000001", Interval{Months: 14, Days: 3, Duration: time.Microsecond}},
+		{"49:30:00", Interval{Duration: 49*time.Hour + 30*time.Minute}},
+		{"@ 1 year 2 mons 4 hours 5 mins 6.5 secs ago", Interval{Years: -1, Months: -2, Duration: -(4*time.Hour + 5*time.Minute + 6500*time.Millisecond)}},
+	}
+	for _, tt := range tests {
+		is := is.New(t)
+		var got Interval
+		is.NoErr(got.Scan(tt.src))
+		is.Equal(tt.want, got)
+	}
+	is := is.New(t)
+	var i Interval
+	is.True(i.Scan("1 fortnight") != nil)
+	is.True(i.Scan("1") != nil)
+	is.True(i.Scan("a:b") != nil)
+	is.True(i.Scan(1) != nil)
+}
+
+func TestInterval_TotalDuration(t *testing.T) {
+	is := is.New(t)
+	is.Equal(90*time.Minute, Interval{Duration: 90 * time.Minute}.TotalDuration())
+	is.Equal(31*24*time.Hour+time.Hour, Interval{Months: 1, Days: 1, Duration: time.Hour}.TotalDuration())
+	is.Equal(-(365*24*time.Hour + 6*time.Hour), Interval{Years: -1}.TotalDuration())
+}
@@ -93,11 +93,7 @@ func (join JoinTable) AppendSQL(buf *strings.Builder, args *[]interface{}, param
 		join.Table.AppendSQL(buf, args, nil)
 	}
 	if join.Table != nil {
-		alias := join.Table.GetAlias()
-		if alias != "" {
-			buf.WriteString(" AS ")
-			buf.WriteString(alias)
-		}
+		appendTableAlias(buf, join.Table)
 	}
 	if len(join.OnPredicates.Predicates) > 0 {
 		buf.WriteString(" ON ")
@@ -120,3 +116,72 @@ func (joins JoinTables) AppendSQL(buf *strings.Builder, args *[]interface{}, par
 		join.AppendSQL(buf, args, nil)
 	}
 }
+
+// JoinedTables is a FROM table together with the tables joined onto it. It is
+// meant to be embedded in a struct alongside the tables that it joins, so that
+// the same set of JOINs can be reused across queries with Fromx and Usingx.
+type JoinedTables struct {
+	FromTable  Table
+	JoinTables JoinTables
+}
+
+// JoinedTablesGetter is anything that can return a JoinedTables. Any struct
+// that embeds JoinedTables satisfies this interface.
+type JoinedTablesGetter interface {
+	GetJoinedTables() JoinedTables
+}
+
+// JoinedFrom creates a new JoinedTables with table as the FROM table.
+func JoinedFrom(table Table) JoinedTables {
+	return JoinedTables{
+		FromTable: table,
+	}
+}
+
+// GetJoinedTables implements the JoinedTablesGetter interface.
+func (jt JoinedTables) GetJoinedTables() JoinedTables {
+	return jt
+}
+
+// appendJoin returns a new JoinedTables with the join appended. The
+// JoinTables are always copied so that appending to a shared JoinedTables
+// never affects the other queries using it.
+func (jt JoinedTables) appendJoin(join JoinTable) JoinedTables {
+	jt.JoinTables = append(jt.JoinTables[:len(jt.JoinTables):len(jt.JoinTables)], join)
+	return jt
+}
+
+// Join joins a new table to the JoinedTables based on the predicates.
+func (jt JoinedTables) Join(table Table, predicate Predicate, predicates ...Predicate) JoinedTables {
+	predicates = append([]Predicate{predicate}, predicates...)
+	return jt.appendJoin(Join(table, predicates...))
+}
+
+// LeftJoin left joins a new table to the JoinedTables based on the predicates.
+func (jt JoinedTables) LeftJoin(table Table, predicate Predicate, predicates ...Predicate) JoinedTables {
+	predicates = append([]Predicate{predicate}, predicates...)
+	return jt.appendJoin(LeftJoin(table, predicates...))
+}
+
+// RightJoin right joins a new table to the JoinedTables based on the predicates.
+func (jt JoinedTables) RightJoin(table Table, predicate Predicate, predicates ...Predicate) JoinedTables {
+	predicates = append([]Predicate{predicate}, predicates...)
+	return jt.appendJoin(RightJoin(table, predicates...))
+}
+
+// FullJoin full joins a table to the JoinedTables based on the predicates.
+func (jt JoinedTables) FullJoin(table Table, predicate Predicate, predicates ...Predicate) JoinedTables {
+	predicates = append([]Predicate{predicate}, predicates...)
+	return jt.appendJoin(FullJoin(table, predicates...))
+}
+
+// CustomJoin custom joins a table to the JoinedTables. The join type can be
+// specified with a string, e.g. "CROSS JOIN".
+func (jt JoinedTables) CustomJoin(joinType JoinType, table Table, predicates ...Predicate) JoinedTables {
+	return jt.appendJoin(CustomJoin(joinType, table, predicates...))
+}
+
+// prependJoins returns the JoinedTables' JoinTables followed by the joins.
+func (jt JoinedTables) prependJoins(joins JoinTables) JoinTables {
+	return append(jt.JoinTables[:len(jt.JoinTables):len(jt.JoinTables)], joins...)
+}
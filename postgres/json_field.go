@@ -3,18 +3,30 @@ package sq
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"reflect"
+	"strconv"
 	"strings"
 )
 
-// JSONField either represents a JSON column or a literal value that can be
-// marshalled into a JSON string.
+// JSONField either represents a JSON column, a JSON expression or a literal
+// value that can be marshalled into a JSON string.
 type JSONField struct {
 	// JSONField will be one of the following:
 
-	// 1) Literal JSONable value (almost all structs can be converted to JSON)
+	// 1) JSON expression
+	// Examples of JSON expressions:
+	// | query                             | args     |
+	// |-----------------------------------|----------|
+	// | tbl.data -> ?                     | address  |
+	// | tbl.data #> ARRAY[?, ?]           | a, b     |
+	// | jsonb_set(tbl.data, ARRAY[?], ?)  | a, "1"   |
+	format *string
+	values []interface{}
+
+	// 2) Literal JSONable value (almost all structs can be converted to JSON)
 	value interface{}
 
-	// 2) JSON column
+	// 3) JSON column
 	alias      string
 	table      Table
 	name       string
@@ -26,12 +38,15 @@ type JSONField struct {
 // described in the JSONField internal struct comments.
 func (f JSONField) AppendSQLExclude(buf *strings.Builder, args *[]interface{}, params map[string]int, excludedTableQualifiers []string) {
 	switch {
+	case f.format != nil:
+		// 1) JSON expression
+		expandValues(buf, args, excludedTableQualifiers, *f.format, f.values)
 	case f.value != nil:
-		// 1) Literal JSONable value
+		// 2) Literal JSONable value
 		buf.WriteString("?")
 		*args = append(*args, f.value)
 	default:
-		// 2) JSON column
+		// 3) JSON column
 		tableQualifier := f.table.GetAlias()
 		if tableQualifier == "" {
 			tableQualifier = f.table.GetName()
@@ -179,6 +194,13 @@ func (f JSONField) NullsLast() JSONField {
 	return f
 }
 
+// ordering implements the orderedField interface.
+func (f JSONField) ordering() (field Field, descending, nullsfirst *bool) {
+	descending, nullsfirst = f.descending, f.nullsfirst
+	f.descending, f.nullsfirst = nil, nil
+	return f, descending, nullsfirst
+}
+
 // IsNull returns an 'X IS NULL' Predicate.
 func (f JSONField) IsNull() Predicate {
 	return CustomPredicate{
@@ -195,6 +217,17 @@ func (f JSONField) IsNotNull() Predicate {
 	}
 }
 
+// Filter returns a new JSONField that only aggregates the rows which satisfy
+// the predicate i.e. 'field FILTER (WHERE predicate)'. The JSONField must be an
+// aggregate function e.g. JSONAgg(field).
+func (f JSONField) Filter(predicate Predicate) JSONField {
+	format := "? FILTER (WHERE ?)"
+	return JSONField{
+		format: &format,
+		values: []interface{}{f, toplevelPredicate(predicate)},
+	}
+}
+
 // String implements the fmt.Stringer interface. It returns the string
 // representation of a JSONField.
 func (f JSONField) String() string {
@@ -215,3 +248,208 @@ func (f JSONField) GetAlias() string {
 func (f JSONField) GetName() string {
 	return f.name
 }
+
+// jsonKey returns the format and values for a JSON object key or array index.
+// Array indexes (any integer type) are written directly into the query,
+// because a placeholder would be taken to be an object key.
+func jsonKey(key interface{}) (format string, values []interface{}) {
+	v := reflect.ValueOf(key)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	default:
+		return "?", []interface{}{key}
+	}
+}
+
+// jsonPath returns the format and values for a JSON path as a text array.
+func jsonPath(path []string) (format string, values []interface{}) {
+	if len(path) == 0 {
+		return "'{}'", nil
+	}
+	return "ARRAY[?]", []interface{}{path}
+}
+
+// jsonValue returns the value to be compared with or stored in a JSON column.
+// Fields and driver.Valuers are used as they are, []byte is taken to be raw
+// JSON and anything else is marshalled into JSON. It panics if the value
+// cannot be marshalled into JSON.
+func jsonValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case SQLExcludeAppender, SQLAppender, driver.Valuer:
+		return value
+	case []byte:
+		return string(v)
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+// Get returns the JSON object field or array element of the JSONField i.e.
+// 'field -> key'. The key is either a string object key or an int array index.
+func (f JSONField) Get(key interface{}) JSONField {
+	keyFormat, keyValues := jsonKey(key)
+	format := "? -> " + keyFormat
+	return JSONField{
+		format: &format,
+		values: append([]interface{}{f}, keyValues...),
+	}
+}
+
+// GetText returns the JSON object field or array element of the JSONField as
+// text i.e. 'field ->> key'. The key is either a string object key or an int
+// array index.
+func (f JSONField) GetText(key interface{}) StringField {
+	keyFormat, keyValues := jsonKey(key)
+	format := "? ->> " + keyFormat
+	return StringField{
+		format: &format,
+		values: append([]interface{}{f}, keyValues...),
+	}
+}
+
+// Path returns the JSON value at the path of the JSONField i.e.
+// 'field #> path'.
+func (f JSONField) Path(path ...string) JSONField {
+	pathFormat, pathValues := jsonPath(path)
+	format := "? #> " + pathFormat
+	return JSONField{
+		format: &format,
+		values: append([]interface{}{f}, pathValues...),
+	}
+}
+
+// PathText returns the JSON value at the path of the JSONField as text i.e.
+// 'field #>> path'.
+func (f JSONField) PathText(path ...string) StringField {
+	pathFormat, pathValues := jsonPath(path)
+	format := "? #>> " + pathFormat
+	return StringField{
+		format: &format,
+		values: append([]interface{}{f}, pathValues...),
+	}
+}
+
+// Contains returns an 'X @> Y' Predicate. The value may be a JSONField, raw
+// JSON as a []byte or any value that can be marshalled into JSON.
+func (f JSONField) Contains(value interface{}) Predicate {
+	return CustomPredicate{
+		Format: "? @> ?",
+		Values: []interface{}{f, jsonValue(value)},
+	}
+}
+
+// ContainedBy returns an 'X <@ Y' Predicate. The value may be a JSONField, raw
+// JSON as a []byte or any value that can be marshalled into JSON.
+func (f JSONField) ContainedBy(value interface{}) Predicate {
+	return CustomPredicate{
+		Format: "? <@ ?",
+		Values: []interface{}{f, jsonValue(value)},
+	}
+}
+
+// HasKey returns an 'X ? Y' Predicate.
+func (f JSONField) HasKey(key string) Predicate {
+	// the ? operator is escaped as ?? so that it isn't mistaken for a
+	// placeholder
+	return CustomPredicate{
+		Format: "? ? ?",
+		Values: []interface{}{f, Literal("??"), key},
+	}
+}
+
+// HasAnyKeys returns an 'X ?| Y' Predicate.
+func (f JSONField) HasAnyKeys(keys ...string) Predicate {
+	pathFormat, pathValues := jsonPath(keys)
+	return CustomPredicate{
+		Format: "? ? " + pathFormat,
+		Values: append([]interface{}{f, Literal("??|")}, pathValues...),
+	}
+}
+
+// HasAllKeys returns an 'X ?& Y' Predicate.
+func (f JSONField) HasAllKeys(keys ...string) Predicate {
+	pathFormat, pathValues := jsonPath(keys)
+	return CustomPredicate{
+		Format: "? ? " + pathFormat,
+		Values: append([]interface{}{f, Literal("??&")}, pathValues...),
+	}
+}
+
+// JSONPathExists returns an 'X @? Y' Predicate, which checks if the JSON path
+// returns any item for the JSONField.
+func (f JSONField) JSONPathExists(jsonpath string) Predicate {
+	return CustomPredicate{
+		Format: "? ? ?",
+		Values: []interface{}{f, Literal("@??"), jsonpath},
+	}
+}
+
+// JSONPathMatch returns an 'X @@ Y' Predicate, which checks the result of the
+// JSON path predicate for the JSONField.
+func (f JSONField) JSONPathMatch(jsonpath string) Predicate {
+	return CustomPredicate{
+		Format: "? @@ ?",
+		Values: []interface{}{f, jsonpath},
+	}
+}
+
+// SetPath returns the JSONField with the value at the path replaced (or added,
+// if it is missing) i.e. 'jsonb_set(field, path, value)'. The value may be a
+// JSONField, raw JSON as a []byte or any value that can be marshalled into
+// JSON.
+//
+//	Update(tbl).Set(tbl.DATA.Set(tbl.DATA.SetPath([]string{"a", "b"}, 1)))
+func (f JSONField) SetPath(path []string, value interface{}) JSONField {
+	pathFormat, pathValues := jsonPath(path)
+	format := "jsonb_set(?, " + pathFormat + ", ?)"
+	values := append([]interface{}{f}, pathValues...)
+	return JSONField{
+		format: &format,
+		values: append(values, jsonValue(value)),
+	}
+}
+
+// InsertPath returns the JSONField with the value inserted at the path i.e.
+// 'jsonb_insert(field, path, value)'. The value may be a JSONField, raw JSON
+// as a []byte or any value that can be marshalled into JSON.
+func (f JSONField) InsertPath(path []string, value interface{}) JSONField {
+	pathFormat, pathValues := jsonPath(path)
+	format := "jsonb_insert(?, " + pathFormat + ", ?)"
+	values := append([]interface{}{f}, pathValues...)
+	return JSONField{
+		format: &format,
+		values: append(values, jsonValue(value)),
+	}
+}
+
+// Minus returns the JSONField with the key removed i.e. 'field - key'. The key
+// is either a string object key or an int array index.
+func (f JSONField) Minus(key interface{}) JSONField {
+	keyFormat, keyValues := jsonKey(key)
+	format := "? - " + keyFormat
+	if f.format != nil {
+		// - binds tighter than the other JSON operators
+		format = "(?) - " + keyFormat
+	}
+	return JSONField{
+		format: &format,
+		values: append([]interface{}{f}, keyValues...),
+	}
+}
+
+// MinusPath returns the JSONField with the value at the path removed i.e.
+// 'field #- path'.
+func (f JSONField) MinusPath(path ...string) JSONField {
+	pathFormat, pathValues := jsonPath(path)
+	format := "? #- " + pathFormat
+	return JSONField{
+		format: &format,
+		values: append([]interface{}{f}, pathValues...),
+	}
+}
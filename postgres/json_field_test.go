@@ -85,6 +85,60 @@ func TestJSONField_AppendSQLExclude(t *testing.T) {
 			wantQuery := `"registered users"."zip code" NULLS LAST`
 			return TT{desc, f, nil, wantQuery, nil}
 		}(),
+		func() TT {
+			desc := "Get"
+			f := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).Get("address").Get(0)
+			wantQuery := "users.data -> ? -> 0"
+			return TT{desc, f, nil, wantQuery, []interface{}{"address"}}
+		}(),
+		func() TT {
+			desc := "Get other integer types"
+			f := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).Get(int32(0)).Get(uint8(1)).Get(int16(-1))
+			wantQuery := "users.data -> 0 -> 1 -> -1"
+			return TT{desc, f, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "Path"
+			f := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).Path("address", "city")
+			wantQuery := "users.data #> ARRAY[?, ?]"
+			return TT{desc, f, nil, wantQuery, []interface{}{"address", "city"}}
+		}(),
+		func() TT {
+			desc := "empty Path"
+			f := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).Path()
+			wantQuery := "users.data #> '{}'"
+			return TT{desc, f, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "SetPath"
+			f := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).SetPath([]string{"tags"}, []string{"a", "b"})
+			wantQuery := "jsonb_set(users.data, ARRAY[?], ?)"
+			return TT{desc, f, nil, wantQuery, []interface{}{"tags", `["a","b"]`}}
+		}(),
+		func() TT {
+			desc := "InsertPath"
+			f := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).InsertPath([]string{"tags", "0"}, "c")
+			wantQuery := "jsonb_insert(users.data, ARRAY[?, ?], ?)"
+			return TT{desc, f, nil, wantQuery, []interface{}{"tags", "0", `"c"`}}
+		}(),
+		func() TT {
+			desc := "Minus"
+			f := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).Minus("password")
+			wantQuery := "users.data - ?"
+			return TT{desc, f, nil, wantQuery, []interface{}{"password"}}
+		}(),
+		func() TT {
+			desc := "Minus expression"
+			f := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).Get("tags").Minus(0)
+			wantQuery := "(users.data -> ?) - 0"
+			return TT{desc, f, nil, wantQuery, []interface{}{"tags"}}
+		}(),
+		func() TT {
+			desc := "MinusPath"
+			f := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).MinusPath("address", "city")
+			wantQuery := "users.data #- ARRAY[?, ?]"
+			return TT{desc, f, nil, wantQuery, []interface{}{"address", "city"}}
+		}(),
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -173,6 +227,61 @@ func TestJSONField_Predicates(t *testing.T) {
 			wantQuery := `"registered users"."zip code" IS NOT NULL`
 			return TT{desc, p, nil, wantQuery, nil}
 		}(),
+		func() TT {
+			desc := "GetText"
+			p := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).GetText("email").EqString("bob@email.com")
+			wantQuery := "users.data ->> ? = ?"
+			return TT{desc, p, nil, wantQuery, []interface{}{"email", "bob@email.com"}}
+		}(),
+		func() TT {
+			desc := "PathText"
+			p := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).PathText("address", "city").IsNull()
+			wantQuery := "users.data #>> ARRAY[?, ?] IS NULL"
+			return TT{desc, p, nil, wantQuery, []interface{}{"address", "city"}}
+		}(),
+		func() TT {
+			desc := "Contains"
+			p := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).Contains(map[string]int{"a": 1})
+			wantQuery := "users.data @> ?"
+			return TT{desc, p, nil, wantQuery, []interface{}{`{"a":1}`}}
+		}(),
+		func() TT {
+			desc := "ContainedBy"
+			f := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"})
+			p := f.ContainedBy(f)
+			wantQuery := "users.data <@ users.data"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "HasKey"
+			p := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).HasKey("email")
+			wantQuery := "users.data ?? ?"
+			return TT{desc, p, nil, wantQuery, []interface{}{"email"}}
+		}(),
+		func() TT {
+			desc := "HasAnyKeys"
+			p := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).HasAnyKeys("email", "phone")
+			wantQuery := "users.data ??| ARRAY[?, ?]"
+			return TT{desc, p, nil, wantQuery, []interface{}{"email", "phone"}}
+		}(),
+		func() TT {
+			desc := "HasAllKeys"
+			p := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).HasAllKeys()
+			wantQuery := "users.data ??& '{}'"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "JSONPathExists"
+			p := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).JSONPathExists("$.tags[*] ? (@ == \"a\")")
+			wantQuery := "users.data @?? ?"
+			return TT{desc, p, nil, wantQuery, []interface{}{`$.tags[*] ? (@ == "a")`}}
+		}(),
+		func() TT {
+			desc := "JSONPathMatch"
+			p := NewJSONField("data", &TableInfo{Schema: "public", Name: "users"}).JSONPathMatch("$.age > 18")
+			wantQuery := "users.data @@ ?"
+			return TT{desc, p, nil, wantQuery, []interface{}{"$.age > 18"}}
+		}(),
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -188,6 +297,30 @@ func TestJSONField_Predicates(t *testing.T) {
 	}
 }
 
+func TestJSONField_Operators(t *testing.T) {
+	is := is.New(t)
+	a := APPLICATIONS()
+	gotQuery, gotArgs := Select(a.APPLICATION_DATA.GetText("email")).
+		From(a).
+		Where(
+			a.APPLICATION_DATA.HasKey("email"),
+			a.APPLICATION_DATA.HasAnyKeys("phone", "address"),
+			a.APPLICATION_DATA.JSONPathExists("$.tags"),
+		).
+		ToSQL()
+	is.Equal("SELECT applications.application_data ->> $1 FROM public.applications"+
+		" WHERE applications.application_data ? $2"+
+		" AND applications.application_data ?| ARRAY[$3, $4]"+
+		" AND applications.application_data @? $5", gotQuery)
+	is.Equal([]interface{}{"email", "email", "phone", "address", "$.tags"}, gotArgs)
+
+	gotQuery, gotArgs = Update(a).
+		Set(a.APPLICATION_DATA.Set(a.APPLICATION_DATA.SetPath([]string{"status"}, "done").Minus("draft"))).
+		ToSQL()
+	is.Equal("UPDATE public.applications SET application_data = (jsonb_set(application_data, ARRAY[$1], $2)) - $3", gotQuery)
+	is.Equal([]interface{}{"status", `"done"`, "draft"}, gotArgs)
+}
+
 type customValuer string
 
 func (v customValuer) Value() (driver.Value, error) {
@@ -0,0 +1,141 @@
+package sq
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// PgxConn is the subset of methods shared by *pgx.Conn, *pgxpool.Pool,
+// *pgxpool.Conn and pgx.Tx that is needed to run queries with pgx.
+type PgxConn interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+}
+
+// PgxDB wraps a pgx connection, pool or transaction so that it can be passed
+// in wherever a DB is expected. Queries fetched with a PgxDB are run natively
+// on pgx, so results are decoded with pgx's binary protocol and arrays are
+// scanned without going through lib/pq.
+//
+// Since pgx rows cannot be converted into *sql.Rows, the Query and
+// QueryContext methods of PgxDB always return an error: use Fetch or
+// FetchContext on the query instead.
+type PgxDB struct {
+	Conn PgxConn
+}
+
+// NewPgxDB creates a new PgxDB from a pgx connection, pool or transaction.
+func NewPgxDB(conn PgxConn) PgxDB {
+	return PgxDB{Conn: conn}
+}
+
+var errPgxDBQuery = errors.New("PgxDB cannot return *sql.Rows, use Fetch/FetchContext instead")
+
+// Query implements the DB interface. It always returns an error.
+func (db PgxDB) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, errPgxDBQuery
+}
+
+// QueryContext implements the DB interface. It always returns an error.
+func (db PgxDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, errPgxDBQuery
+}
+
+// Exec implements the DB interface.
+func (db PgxDB) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return db.ExecContext(context.Background(), query, args...)
+}
+
+// ExecContext implements the DB interface.
+func (db PgxDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	tag, err := db.Conn.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return pgxResult{tag: tag}, nil
+}
+
+// pgxResult implements sql.Result for a pgconn.CommandTag.
+type pgxResult struct {
+	tag pgconn.CommandTag
+}
+
+// LastInsertId implements sql.Result. Postgres does not support it, use a
+// RETURNING clause instead.
+func (res pgxResult) LastInsertId() (int64, error) {
+	return 0, errors.New("LastInsertId is not supported by postgres")
+}
+
+// RowsAffected implements sql.Result.
+func (res pgxResult) RowsAffected() (int64, error) {
+	return res.tag.RowsAffected(), nil
+}
+
+// pgxRows adapts pgx.Rows to the rowsIterator interface.
+type pgxRows struct {
+	pgx.Rows
+}
+
+// Close closes the rows and returns any error encountered while reading them.
+func (rows pgxRows) Close() error {
+	rows.Rows.Close()
+	return rows.Rows.Err()
+}
+
+// rowsIterator is the interface that Row reads query results from. Both
+// *sql.Rows and pgxRows satisfy it.
+type rowsIterator interface {
+	Next() bool
+	Scan(dest ...interface{}) error
+	Close() error
+	Err() error
+}
+
+// asPgxDB returns the PgxDB if the DB is either a PgxDB or a non-nil *PgxDB.
+func asPgxDB(db DB) (PgxDB, bool) {
+	switch db := db.(type) {
+	case PgxDB:
+		return db, true
+	case *PgxDB:
+		if db != nil {
+			return *db, true
+		}
+	}
+	return PgxDB{}, false
+}
+
+// newRow creates a new Row that will read its results from the DB.
+func newRow(db DB) *Row {
+	_, isPgx := asPgxDB(db)
+	return &Row{pgx: isPgx}
+}
+
+// queryRows runs the query with the DB and returns the resulting rows. If the
+// DB is a PgxDB, the query is run natively on pgx.
+func queryRows(ctx context.Context, db DB, query string, args []interface{}) (rowsIterator, error) {
+	if pgxDB, ok := asPgxDB(db); ok {
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		rows, err := pgxDB.Conn.Query(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+		return pgxRows{Rows: rows}, nil
+	}
+	var rows *sql.Rows
+	var err error
+	if ctx == nil {
+		rows, err = db.Query(query, args...)
+	} else {
+		rows, err = db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -0,0 +1,244 @@
+package sq
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/matryer/is"
+)
+
+// fakePgxConn records the queries it receives and returns canned results.
+type fakePgxConn struct {
+	query   string
+	args    []interface{}
+	results [][]interface{}
+	tag     pgconn.CommandTag
+}
+
+func (conn *fakePgxConn) Query(ctx context.Context, query string, args ...interface{}) (pgx.Rows, error) {
+	conn.query, conn.args = query, args
+	return &fakePgxRows{results: conn.results, index: -1}, nil
+}
+
+func (conn *fakePgxConn) Exec(ctx context.Context, query string, args ...interface{}) (pgconn.CommandTag, error) {
+	conn.query, conn.args = query, args
+	return conn.tag, nil
+}
+
+// pgxBinary is a result that fakePgxRows sends in the binary format, and
+// decodes with pgtype the way pgx does.
+type pgxBinary struct {
+	oid   uint32
+	value interface{}
+}
+
+// fakePgxRows scans its results the way pgx does: sql.Scanners are handed the
+// value, everything else is assigned to directly.
+type fakePgxRows struct {
+	pgx.Rows
+	results [][]interface{}
+	index   int
+}
+
+func (rows *fakePgxRows) Next() bool {
+	rows.index++
+	return rows.index < len(rows.results)
+}
+
+func (rows *fakePgxRows) Scan(dest ...interface{}) error {
+	result := rows.results[rows.index]
+	if len(dest) != len(result) {
+		return fmt.Errorf("expected %d destinations, got %d", len(result), len(dest))
+	}
+	for i := range dest {
+		if binary, ok := result[i].(pgxBinary); ok {
+			m := pgtype.NewMap()
+			src, err := m.Encode(binary.oid, pgtype.BinaryFormatCode, binary.value, nil)
+			if err != nil {
+				return err
+			}
+			if err = m.Scan(binary.oid, pgtype.BinaryFormatCode, src, dest[i]); err != nil {
+				return err
+			}
+			continue
+		}
+		if scanner, ok := dest[i].(sql.Scanner); ok {
+			if err := scanner.Scan(result[i]); err != nil {
+				return err
+			}
+			continue
+		}
+		destValue := reflect.ValueOf(dest[i]).Elem()
+		if result[i] == nil {
+			destValue.Set(reflect.Zero(destValue.Type()))
+			continue
+		}
+		destValue.Set(reflect.ValueOf(result[i]))
+	}
+	return nil
+}
+
+func (rows *fakePgxRows) Close() {}
+
+func (rows *fakePgxRows) Err() error { return nil }
+
+func TestPgxDB(t *testing.T) {
+	is := is.New(t)
+	u := USERS()
+	conn := &fakePgxConn{
+		results: [][]interface{}{
+			{int64(1), "alice", []int64{1, 2}},
+			{int64(2), "bob", []int64{3}},
+		},
+		tag: pgconn.NewCommandTag("UPDATE 2"),
+	}
+	db := NewPgxDB(conn)
+
+	// Fetch
+	type result struct {
+		UserID      int
+		Displayname string
+		Numbers     []int64
+	}
+	var results []result
+	var res result
+	err := From(u).Where(u.USER_ID.LeInt(2)).Selectx(func(row *Row) {
+		res.UserID = row.Int(u.USER_ID)
+		res.Displayname = row.String(u.DISPLAYNAME)
+		// pgx scans arrays natively: a lib/pq array would choke on []int64
+		row.ScanArray(&res.Numbers, Fieldf("ARRAY[1, 2]"))
+	}, func() {
+		results = append(results, res)
+	}).Fetch(db)
+	is.NoErr(err)
+	is.Equal("SELECT users.user_id, users.displayname, ARRAY[1, 2] FROM public.users WHERE users.user_id <= $1", conn.query)
+	is.Equal([]interface{}{2}, conn.args)
+	is.Equal([]result{{1, "alice", []int64{1, 2}}, {2, "bob", []int64{3}}}, results)
+
+	// FetchSlice
+	conn.results = [][]interface{}{{"alice"}, {"bob"}}
+	names, err := FetchSlice(db, From(u), func(row *Row) string {
+		return row.String(u.DISPLAYNAME)
+	})
+	is.NoErr(err)
+	is.Equal([]string{"alice", "bob"}, names)
+
+	// Exec
+	rowsAffected, err := Update(u).Set(u.EMAIL.SetString("x")).Where(u.USER_ID.LeInt(2)).Exec(db, ErowsAffected)
+	is.NoErr(err)
+	is.Equal(int64(2), rowsAffected)
+	is.Equal("UPDATE public.users SET email = $1 WHERE users.user_id <= $2", conn.query)
+	res2, err := db.Exec("DELETE FROM public.users")
+	is.NoErr(err)
+	_, err = res2.LastInsertId()
+	is.True(err != nil)
+
+	// PgxDB cannot return *sql.Rows
+	_, err = db.Query("SELECT 1")
+	is.True(err != nil)
+	_, err = db.QueryContext(context.Background(), "SELECT 1")
+	is.True(err != nil)
+}
+
+func TestPgxDB_Pointer(t *testing.T) {
+	is := is.New(t)
+	u := USERS()
+	conn := &fakePgxConn{
+		results: [][]interface{}{{int64(1), []int64{1, 2}}},
+	}
+	var userID int
+	var numbers []int64
+	err := From(u).SelectRowx(func(row *Row) {
+		userID = row.Int(u.USER_ID)
+		row.ScanArray(&numbers, Fieldf("ARRAY[1, 2]"))
+	}).Fetch(&PgxDB{Conn: conn})
+	is.NoErr(err)
+	is.Equal("SELECT users.user_id, ARRAY[1, 2] FROM public.users", conn.query)
+	is.Equal(1, userID)
+	is.Equal([]int64{1, 2}, numbers)
+
+	// a nil *PgxDB is not treated as a PgxDB
+	_, ok := asPgxDB((*PgxDB)(nil))
+	is.True(!ok)
+}
+
+func TestPgxDB_Range(t *testing.T) {
+	is := is.New(t)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	conn := &fakePgxConn{
+		results: [][]interface{}{{
+			pgxBinary{pgtype.TstzrangeOID, pgtype.Range[time.Time]{
+				Lower: start, LowerType: pgtype.Inclusive, UpperType: pgtype.Unbounded, Valid: true,
+			}},
+			pgxBinary{pgtype.Int4rangeOID, pgtype.Range[int]{LowerType: pgtype.Empty, UpperType: pgtype.Empty, Valid: true}},
+			pgxBinary{pgtype.Int8rangeOID, nil},
+		}},
+	}
+	var times Range[time.Time]
+	var seats Range[int]
+	var ids Range[int64]
+	err := SelectRowx(func(row *Row) {
+		row.Range(&times, TSTZRange(start, nil, "[)"))
+		row.Range(&seats, Int4Range(1, 1, "[)"))
+		row.Range(&ids, Int8Range(nil, nil, "[)"))
+	}).Fetch(NewPgxDB(conn))
+	is.NoErr(err)
+	is.True(times.Lower.Equal(start))
+	times.Lower = start // pgx decodes timestamptz in the local time zone
+	is.Equal(Range[time.Time]{Lower: start, LowerInclusive: true, UpperUnbounded: true, Valid: true}, times)
+	is.Equal(Range[int]{Empty: true, Valid: true}, seats)
+	is.Equal(Range[int64]{}, ids)
+}
+
+func TestPgxDB_Fetch(t *testing.T) {
+	if testing.Short() {
+		return
+	}
+	is := is.New(t)
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, fmt.Sprintf("postgres://%s:%s@localhost:%s/%s?sslmode=disable",
+		os.Getenv("POSTGRES_USER"),
+		os.Getenv("POSTGRES_PASSWORD"),
+		os.Getenv("POSTGRES_PORT"),
+		os.Getenv("POSTGRES_NAME"),
+	))
+	is.NoErr(err)
+	defer conn.Close(ctx)
+	tx, err := conn.Begin(ctx)
+	is.NoErr(err)
+	defer tx.Rollback(ctx)
+	db := NewPgxDB(tx)
+	u := USERS()
+
+	user := &User{}
+	var users []User
+	err = From(u).
+		Where(u.USER_ID.LeInt(5)).
+		OrderBy(u.USER_ID).
+		Selectx(user.RowMapper(u), func() { users = append(users, *user) }).
+		FetchContext(ctx, db)
+	is.NoErr(err)
+	is.Equal(5, len(users))
+
+	var numbers []int64
+	err = SelectRowx(func(row *Row) {
+		row.ScanArray(&numbers, Array([]int64{1, 2, 3}))
+	}).Fetch(db)
+	is.NoErr(err)
+	is.Equal([]int64{1, 2, 3}, numbers)
+
+	rowsAffected, err := Update(u).
+		Set(u.DISPLAYNAME.SetString("x")).
+		Where(u.USER_ID.LeInt(5)).
+		Exec(db, ErowsAffected)
+	is.NoErr(err)
+	is.Equal(int64(5), rowsAffected)
+}
@@ -0,0 +1,608 @@
+package sq
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/lib/pq"
+)
+
+// RangeField either represents a range column or a range expression.
+type RangeField struct {
+	// elemType is the type of the elements of the range e.g. timestamptz for a
+	// tstzrange. It is used to cast Go values that are compared against the
+	// range, and is empty if the range type is unknown.
+	elemType string
+
+	// RangeField will be one of the following:
+
+	// 1) Range expression
+	// Examples of range expressions:
+	// | query                      | args                   |
+	// |----------------------------|------------------------|
+	// | tstzrange(?, ?, '[)')      | 2020-01-01, 2020-02-01 |
+	// | int4range(tbl.start, NULL) |                        |
+	format *string
+	values []interface{}
+
+	// 2) Range column
+	// Examples of range columns:
+	// | query           | args |
+	// |-----------------|------|
+	// | bookings.period |      |
+	// | period          |      |
+	alias      string
+	table      Table
+	name       string
+	descending *bool
+	nullsfirst *bool
+}
+
+// AppendSQLExclude marshals the RangeField into a buffer and an args slice. It
+// will not table qualify itself if its table qualifer appears in the
+// excludedTableQualifiers list.
+func (f RangeField) AppendSQLExclude(buf *strings.Builder, args *[]interface{}, params map[string]int, excludedTableQualifiers []string) {
+	switch {
+	case f.format != nil:
+		// 1) Range expression
+		expandValues(buf, args, excludedTableQualifiers, *f.format, f.values)
+	default:
+		// 2) Range column
+		tableQualifier := f.table.GetAlias()
+		if tableQualifier == "" {
+			tableQualifier = f.table.GetName()
+		}
+		for _, excludedTableQualifier := range excludedTableQualifiers {
+			if tableQualifier == excludedTableQualifier {
+				tableQualifier = ""
+				break
+			}
+		}
+		if tableQualifier != "" {
+			if strings.ContainsAny(tableQualifier, " \t") {
+				buf.WriteString(`"`)
+				buf.WriteString(tableQualifier)
+				buf.WriteString(`".`)
+			} else {
+				buf.WriteString(tableQualifier)
+				buf.WriteString(".")
+			}
+		}
+		if strings.ContainsAny(f.name, " \t") {
+			buf.WriteString(`"`)
+			buf.WriteString(f.name)
+			buf.WriteString(`"`)
+		} else {
+			buf.WriteString(f.name)
+		}
+	}
+	if f.descending != nil {
+		if *f.descending {
+			buf.WriteString(" DESC")
+		} else {
+			buf.WriteString(" ASC")
+		}
+	}
+	if f.nullsfirst != nil {
+		if *f.nullsfirst {
+			buf.WriteString(" NULLS FIRST")
+		} else {
+			buf.WriteString(" NULLS LAST")
+		}
+	}
+}
+
+// NewRangeField returns a new RangeField representing a range column of an
+// unknown range type. Prefer the typed constructors like NewTSTZRangeField, so
+// that Go values passed to Contains are cast to the element type.
+func NewRangeField(name string, table Table) RangeField {
+	return RangeField{
+		name:  name,
+		table: table,
+	}
+}
+
+// NewTSTZRangeField returns a new RangeField representing a TSTZRANGE column.
+func NewTSTZRangeField(name string, table Table) RangeField {
+	return RangeField{elemType: "TIMESTAMPTZ", name: name, table: table}
+}
+
+// NewTSRangeField returns a new RangeField representing a TSRANGE column.
+func NewTSRangeField(name string, table Table) RangeField {
+	return RangeField{elemType: "TIMESTAMP", name: name, table: table}
+}
+
+// NewDateRangeField returns a new RangeField representing a DATERANGE column.
+func NewDateRangeField(name string, table Table) RangeField {
+	return RangeField{elemType: "DATE", name: name, table: table}
+}
+
+// NewInt4RangeField returns a new RangeField representing an INT4RANGE
+// column.
+func NewInt4RangeField(name string, table Table) RangeField {
+	return RangeField{elemType: "INTEGER", name: name, table: table}
+}
+
+// NewInt8RangeField returns a new RangeField representing an INT8RANGE
+// column.
+func NewInt8RangeField(name string, table Table) RangeField {
+	return RangeField{elemType: "BIGINT", name: name, table: table}
+}
+
+// NewNumRangeField returns a new RangeField representing a NUMRANGE column.
+func NewNumRangeField(name string, table Table) RangeField {
+	return RangeField{elemType: "NUMERIC", name: name, table: table}
+}
+
+// rangeConstructor returns a RangeField that calls the range constructor
+// function e.g. 'tstzrange(lower, upper, bounds)'. A nil lower or upper means
+// that side of the range is unbounded. The bounds is one of "[)", "[]", "(]"
+// or "()", and defaults to "[)" if it is empty.
+func rangeConstructor(function, elemType string, lower, upper interface{}, bounds string) RangeField {
+	format := function + "(?, ?)"
+	if bounds != "" {
+		format = function + "(?, ?, " + quoteLiteral(bounds) + ")"
+	}
+	return RangeField{
+		elemType: elemType,
+		format:   &format,
+		values:   []interface{}{lower, upper},
+	}
+}
+
+// TSTZRange returns a new tstzrange i.e. 'tstzrange(lower, upper, bounds)'.
+// The lower and upper may be TimeFields, time.Time values or nil for an
+// unbounded side.
+func TSTZRange(lower, upper interface{}, bounds string) RangeField {
+	return rangeConstructor("tstzrange", "TIMESTAMPTZ", lower, upper, bounds)
+}
+
+// TSRange returns a new tsrange i.e. 'tsrange(lower, upper, bounds)'.
+func TSRange(lower, upper interface{}, bounds string) RangeField {
+	return rangeConstructor("tsrange", "TIMESTAMP", lower, upper, bounds)
+}
+
+// DateRange returns a new daterange i.e. 'daterange(lower, upper, bounds)'.
+func DateRange(lower, upper interface{}, bounds string) RangeField {
+	return rangeConstructor("daterange", "DATE", lower, upper, bounds)
+}
+
+// Int4Range returns a new int4range i.e. 'int4range(lower, upper, bounds)'.
+func Int4Range(lower, upper interface{}, bounds string) RangeField {
+	return rangeConstructor("int4range", "INTEGER", lower, upper, bounds)
+}
+
+// Int8Range returns a new int8range i.e. 'int8range(lower, upper, bounds)'.
+func Int8Range(lower, upper interface{}, bounds string) RangeField {
+	return rangeConstructor("int8range", "BIGINT", lower, upper, bounds)
+}
+
+// NumRange returns a new numrange i.e. 'numrange(lower, upper, bounds)'.
+func NumRange(lower, upper interface{}, bounds string) RangeField {
+	return rangeConstructor("numrange", "NUMERIC", lower, upper, bounds)
+}
+
+// Set returns a FieldAssignment associating the RangeField to the value i.e.
+// 'field = value'. The value may be a RangeField or a Range.
+func (f RangeField) Set(value interface{}) FieldAssignment {
+	return FieldAssignment{
+		Field: f,
+		Value: value,
+	}
+}
+
+// As returns a new RangeField with the new field Alias i.e. 'field AS Alias'.
+func (f RangeField) As(alias string) RangeField {
+	f.alias = alias
+	return f
+}
+
+// Asc returns a new RangeField indicating that it should be ordered in
+// ascending order i.e. 'ORDER BY field ASC'.
+func (f RangeField) Asc() RangeField {
+	desc := false
+	f.descending = &desc
+	return f
+}
+
+// Desc returns a new RangeField indicating that it should be ordered in
+// descending order i.e. 'ORDER BY field DESC'.
+func (f RangeField) Desc() RangeField {
+	desc := true
+	f.descending = &desc
+	return f
+}
+
+// NullsFirst returns a new RangeField indicating that it should be ordered
+// with nulls first i.e. 'ORDER BY field NULLS FIRST'.
+func (f RangeField) NullsFirst() RangeField {
+	nullsfirst := true
+	f.nullsfirst = &nullsfirst
+	return f
+}
+
+// NullsLast returns a new RangeField indicating that it should be ordered
+// with nulls last i.e. 'ORDER BY field NULLS LAST'.
+func (f RangeField) NullsLast() RangeField {
+	nullsfirst := false
+	f.nullsfirst = &nullsfirst
+	return f
+}
+
+// ordering implements the orderedField interface.
+func (f RangeField) ordering() (field Field, descending, nullsfirst *bool) {
+	descending, nullsfirst = f.descending, f.nullsfirst
+	f.descending, f.nullsfirst = nil, nil
+	return f, descending, nullsfirst
+}
+
+// IsNull returns an 'X IS NULL' Predicate.
+func (f RangeField) IsNull() Predicate {
+	return CustomPredicate{
+		Format: "? IS NULL",
+		Values: []interface{}{f},
+	}
+}
+
+// IsNotNull returns an 'X IS NOT NULL' Predicate.
+func (f RangeField) IsNotNull() Predicate {
+	return CustomPredicate{
+		Format: "? IS NOT NULL",
+		Values: []interface{}{f},
+	}
+}
+
+// Contains returns an 'X @> Y' Predicate, which is true if the RangeField
+// contains the value. The value may be another range (a RangeField or a Range)
+// or a single element. Go values that are not ranges are cast to the element
+// type of the RangeField, otherwise postgres would try to parse them as a
+// range.
+func (f RangeField) Contains(value interface{}) Predicate {
+	format := "? @> ?"
+	switch value.(type) {
+	case Field, driver.Valuer, nil:
+		break
+	default:
+		if f.elemType != "" {
+			format = "? @> CAST(? AS " + f.elemType + ")"
+		}
+	}
+	return CustomPredicate{
+		Format: format,
+		Values: []interface{}{f, value},
+	}
+}
+
+// ContainedBy returns an 'X <@ Y' Predicate, which is true if the RangeField
+// is contained by the range.
+func (f RangeField) ContainedBy(rng interface{}) Predicate {
+	return CustomPredicate{
+		Format: "? <@ ?",
+		Values: []interface{}{f, rng},
+	}
+}
+
+// Overlaps returns an 'X && Y' Predicate, which is true if the RangeField and
+// the range have any elements in common.
+func (f RangeField) Overlaps(rng interface{}) Predicate {
+	return CustomPredicate{
+		Format: "? && ?",
+		Values: []interface{}{f, rng},
+	}
+}
+
+// StrictlyLeft returns an 'X << Y' Predicate, which is true if every element
+// of the RangeField is less than every element of the range.
+func (f RangeField) StrictlyLeft(rng interface{}) Predicate {
+	return CustomPredicate{
+		Format: "? << ?",
+		Values: []interface{}{f, rng},
+	}
+}
+
+// StrictlyRight returns an 'X >> Y' Predicate, which is true if every element
+// of the RangeField is greater than every element of the range.
+func (f RangeField) StrictlyRight(rng interface{}) Predicate {
+	return CustomPredicate{
+		Format: "? >> ?",
+		Values: []interface{}{f, rng},
+	}
+}
+
+// Adjacent returns an 'X -|- Y' Predicate, which is true if the RangeField
+// and the range touch without overlapping.
+func (f RangeField) Adjacent(rng interface{}) Predicate {
+	return CustomPredicate{
+		Format: "? -|- ?",
+		Values: []interface{}{f, rng},
+	}
+}
+
+// Lower returns the lower bound of the RangeField i.e. 'lower(field)'. It is
+// NULL if the range is empty or the lower bound is unbounded.
+func (f RangeField) Lower() CustomField {
+	return CustomField{
+		Format: "lower(?)",
+		Values: []interface{}{f},
+	}
+}
+
+// Upper returns the upper bound of the RangeField i.e. 'upper(field)'. It is
+// NULL if the range is empty or the upper bound is unbounded.
+func (f RangeField) Upper() CustomField {
+	return CustomField{
+		Format: "upper(?)",
+		Values: []interface{}{f},
+	}
+}
+
+// IsEmpty returns an 'isempty(X)' Predicate, which is true if the RangeField
+// has no elements.
+func (f RangeField) IsEmpty() Predicate {
+	return CustomPredicate{
+		Format: "isempty(?)",
+		Values: []interface{}{f},
+	}
+}
+
+// LowerInf returns a 'lower_inf(X)' Predicate, which is true if the
+// RangeField has no lower bound.
+func (f RangeField) LowerInf() Predicate {
+	return CustomPredicate{
+		Format: "lower_inf(?)",
+		Values: []interface{}{f},
+	}
+}
+
+// UpperInf returns an 'upper_inf(X)' Predicate, which is true if the
+// RangeField has no upper bound.
+func (f RangeField) UpperInf() Predicate {
+	return CustomPredicate{
+		Format: "upper_inf(?)",
+		Values: []interface{}{f},
+	}
+}
+
+// String implements the fmt.Stringer interface. It returns the string
+// representation of a RangeField.
+func (f RangeField) String() string {
+	buf := &strings.Builder{}
+	var args []interface{}
+	f.AppendSQLExclude(buf, &args, nil, nil)
+	return questionInterpolate(buf.String(), args...)
+}
+
+// GetAlias implements the Field interface. It returns the Alias of the
+// RangeField.
+func (f RangeField) GetAlias() string {
+	return f.alias
+}
+
+// GetName implements the Field interface. It returns the Name of the
+// RangeField.
+func (f RangeField) GetName() string {
+	return f.name
+}
+
+// RangeElement is the set of Go types that a Range can hold. Use time.Time for
+// tstzrange, tsrange and daterange, int, int32 or int64 for int4range and
+// int8range, and float64 or string for numrange (string keeps the exact
+// decimal value).
+type RangeElement interface {
+	int | int32 | int64 | float64 | string | time.Time
+}
+
+// Range is a Go representation of a postgres range value. It implements
+// sql.Scanner and driver.Valuer, so it can be scanned with Row.Range and
+// passed to RangeField methods as a literal range. Like the sql.Null types,
+// the zero value is NULL and Valid must be set for anything else; set Empty
+// for the empty range.
+type Range[T RangeElement] struct {
+	Lower          T
+	Upper          T
+	LowerInclusive bool
+	UpperInclusive bool
+	// A range with an unbounded side contains every value on that side, and
+	// the corresponding Lower or Upper is ignored.
+	LowerUnbounded bool
+	UpperUnbounded bool
+	Empty          bool
+	Valid          bool // Valid is true if the range is not NULL
+}
+
+// Value implements the driver.Valuer interface. It returns the text
+// representation of the Range e.g. '[1,10)', or nil if the Range is not
+// Valid.
+func (r Range[T]) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	if r.Empty {
+		return "empty", nil
+	}
+	buf := &strings.Builder{}
+	if r.LowerInclusive && !r.LowerUnbounded {
+		buf.WriteString("[")
+	} else {
+		buf.WriteString("(")
+	}
+	if !r.LowerUnbounded {
+		appendRangeElement(buf, r.Lower)
+	}
+	buf.WriteString(",")
+	if !r.UpperUnbounded {
+		appendRangeElement(buf, r.Upper)
+	}
+	if r.UpperInclusive && !r.UpperUnbounded {
+		buf.WriteString("]")
+	} else {
+		buf.WriteString(")")
+	}
+	return buf.String(), nil
+}
+
+// appendRangeElement writes the text representation of a range bound.
+func appendRangeElement(buf *strings.Builder, value interface{}) {
+	switch v := value.(type) {
+	case time.Time:
+		buf.WriteString(`"`)
+		buf.WriteString(v.Format(time.RFC3339Nano))
+		buf.WriteString(`"`)
+	case int:
+		buf.WriteString(strconv.Itoa(v))
+	case int32:
+		buf.WriteString(strconv.FormatInt(int64(v), 10))
+	case int64:
+		buf.WriteString(strconv.FormatInt(v, 10))
+	case float64:
+		buf.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+	case string:
+		buf.WriteString(v)
+	}
+}
+
+// Scan implements the sql.Scanner interface. It parses the text
+// representation of a postgres range e.g. '["2020-01-01 00:00:00+00",)'. For
+// time ranges, a lower bound of -infinity and an upper bound of infinity are
+// treated as unbounded, since time.Time cannot hold them.
+func (r *Range[T]) Scan(src interface{}) error {
+	var s string
+	switch src := src.(type) {
+	case nil:
+		*r = Range[T]{}
+		return nil
+	case []byte:
+		s = string(src)
+	case string:
+		s = src
+	default:
+		return fmt.Errorf("cannot convert %T to %T", src, r)
+	}
+	*r = Range[T]{Valid: true}
+	if s == "empty" {
+		r.Empty = true
+		return nil
+	}
+	if len(s) < 3 || (s[0] != '[' && s[0] != '(') || (s[len(s)-1] != ']' && s[len(s)-1] != ')') {
+		return fmt.Errorf("invalid range %q", s)
+	}
+	r.LowerInclusive = s[0] == '['
+	r.UpperInclusive = s[len(s)-1] == ']'
+	lower, rest, lowerQuoted, err := parseRangeBound(s[1 : len(s)-1])
+	if err != nil {
+		return fmt.Errorf("invalid range %q: %w", s, err)
+	}
+	if len(rest) == 0 || rest[0] != ',' {
+		return fmt.Errorf("invalid range %q", s)
+	}
+	upper, rest, upperQuoted, err := parseRangeBound(rest[1:])
+	if err != nil {
+		return fmt.Errorf("invalid range %q: %w", s, err)
+	}
+	if len(rest) > 0 {
+		return fmt.Errorf("invalid range %q", s)
+	}
+	_, isTime := any(r.Lower).(time.Time)
+	if (lower == "" && !lowerQuoted) || (isTime && lower == "-infinity") {
+		r.LowerUnbounded = true
+	} else if err = parseRangeElement(lower, &r.Lower); err != nil {
+		return fmt.Errorf("invalid range %q: %w", s, err)
+	}
+	if (upper == "" && !upperQuoted) || (isTime && upper == "infinity") {
+		r.UpperUnbounded = true
+	} else if err = parseRangeElement(upper, &r.Upper); err != nil {
+		return fmt.Errorf("invalid range %q: %w", s, err)
+	}
+	return nil
+}
+
+// pgxRange is implemented by *Range[T]. pgx sends ranges in the binary
+// format, which Scan cannot parse, so Row.Range has pgx scan into a
+// pgtype.Range instead and converts it with setPgxRange.
+type pgxRange interface {
+	newPgxRange() interface{}
+	setPgxRange(src interface{})
+}
+
+func (r *Range[T]) newPgxRange() interface{} {
+	return &pgtype.Range[T]{}
+}
+
+func (r *Range[T]) setPgxRange(src interface{}) {
+	rng := src.(*pgtype.Range[T])
+	*r = Range[T]{Valid: rng.Valid}
+	switch {
+	case !rng.Valid:
+	case rng.LowerType == pgtype.Empty:
+		r.Empty = true
+	default:
+		r.Lower, r.Upper = rng.Lower, rng.Upper
+		r.LowerInclusive = rng.LowerType == pgtype.Inclusive
+		r.UpperInclusive = rng.UpperType == pgtype.Inclusive
+		r.LowerUnbounded = rng.LowerType == pgtype.Unbounded
+		r.UpperUnbounded = rng.UpperType == pgtype.Unbounded
+	}
+}
+
+// parseRangeBound parses a possibly quoted range bound from the start of s and
+// returns the rest of s.
+func parseRangeBound(s string) (bound, rest string, quoted bool, err error) {
+	if len(s) == 0 || s[0] != '"' {
+		i := strings.IndexByte(s, ',')
+		if i < 0 {
+			i = len(s)
+		}
+		return s[:i], s[i:], false, nil
+	}
+	buf := &strings.Builder{}
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+			if i < len(s) {
+				buf.WriteByte(s[i])
+			}
+		case '"':
+			// a doubled quote inside a quoted bound is a literal quote
+			if i+1 < len(s) && s[i+1] == '"' {
+				buf.WriteByte('"')
+				i++
+				continue
+			}
+			return buf.String(), s[i+1:], true, nil
+		default:
+			buf.WriteByte(s[i])
+		}
+	}
+	return "", "", true, fmt.Errorf("unterminated quoted bound")
+}
+
+// parseRangeElement parses the text representation of a range bound into the
+// dest.
+func parseRangeElement[T RangeElement](s string, dest *T) error {
+	var err error
+	switch dest := any(dest).(type) {
+	case *time.Time:
+		if s == "infinity" || s == "-infinity" {
+			return fmt.Errorf("%s cannot be represented as a time.Time", s)
+		}
+		*dest, err = pq.ParseTimestamp(nil, s)
+	case *int:
+		*dest, err = strconv.Atoi(s)
+	case *int32:
+		var n int64
+		n, err = strconv.ParseInt(s, 10, 32)
+		*dest = int32(n)
+	case *int64:
+		*dest, err = strconv.ParseInt(s, 10, 64)
+	case *float64:
+		*dest, err = strconv.ParseFloat(s, 64)
+	case *string:
+		*dest = s
+	}
+	return err
+}
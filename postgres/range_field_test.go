@@ -0,0 +1,188 @@
+package sq
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestRangeField_AppendSQLExclude(t *testing.T) {
+	type TT struct {
+		description string
+		f           Field
+		exclude     []string
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	tbl := &TableInfo{Schema: "public", Name: "bookings"}
+	period := NewTSTZRangeField("period", tbl)
+	seats := NewInt4RangeField("seats", tbl)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	tests := []TT{
+		{"column", period, nil, "bookings.period", nil},
+		{"excluded", period.Desc().NullsLast(), []string{"bookings"}, "period DESC NULLS LAST", nil},
+		{
+			"TSTZRange",
+			TSTZRange(start, nil, "[]"),
+			nil,
+			"tstzrange(?, NULL, '[]')",
+			[]interface{}{start},
+		},
+		{
+			"Int4Range default bounds",
+			Int4Range(NewNumberField("first_seat", tbl), 10, ""),
+			nil,
+			"int4range(bookings.first_seat, ?)",
+			[]interface{}{10},
+		},
+		{
+			"Contains element",
+			period.Contains(start),
+			nil,
+			"bookings.period @> CAST(? AS TIMESTAMPTZ)",
+			[]interface{}{start},
+		},
+		{
+			"Contains field",
+			period.Contains(NewTimeField("created_at", tbl)),
+			nil,
+			"bookings.period @> bookings.created_at",
+			nil,
+		},
+		{
+			"Contains untyped",
+			NewRangeField("period", tbl).Contains(start),
+			nil,
+			"bookings.period @> ?",
+			[]interface{}{start},
+		},
+		{
+			"Contains Range",
+			seats.Contains(Range[int]{Lower: 1, Upper: 5, LowerInclusive: true, Valid: true}),
+			nil,
+			"bookings.seats @> ?",
+			[]interface{}{Range[int]{Lower: 1, Upper: 5, LowerInclusive: true, Valid: true}},
+		},
+		{
+			"ContainedBy",
+			period.ContainedBy(TSTZRange(start, end, "")),
+			nil,
+			"bookings.period <@ tstzrange(?, ?)",
+			[]interface{}{start, end},
+		},
+		{"Overlaps", period.Overlaps(period), nil, "bookings.period && bookings.period", nil},
+		{"StrictlyLeft", seats.StrictlyLeft(Int4Range(1, 5, "")), nil, "bookings.seats << int4range(?, ?)", []interface{}{1, 5}},
+		{"StrictlyRight", seats.StrictlyRight(Int4Range(1, 5, "")), nil, "bookings.seats >> int4range(?, ?)", []interface{}{1, 5}},
+		{"Adjacent", seats.Adjacent(Int4Range(1, 5, "")), nil, "bookings.seats -|- int4range(?, ?)", []interface{}{1, 5}},
+		{"Lower", period.Lower(), nil, "lower(bookings.period)", nil},
+		{"Upper", period.Upper().Desc(), nil, "upper(bookings.period) DESC", nil},
+		{"IsEmpty", period.IsEmpty(), nil, "isempty(bookings.period)", nil},
+		{"LowerInf", period.LowerInf(), nil, "lower_inf(bookings.period)", nil},
+		{"UpperInf", Not(period.UpperInf()), nil, "NOT upper_inf(bookings.period)", nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.f.AppendSQLExclude(buf, &args, nil, tt.exclude)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
+
+func TestRange(t *testing.T) {
+	t.Run("Value", func(t *testing.T) {
+		is := is.New(t)
+		v, err := Range[int]{Lower: 1, Upper: 10, LowerInclusive: true, Valid: true}.Value()
+		is.NoErr(err)
+		is.Equal("[1,10)", v)
+		v, err = Range[float64]{Lower: 1.5, UpperUnbounded: true, UpperInclusive: true, Valid: true}.Value()
+		is.NoErr(err)
+		is.Equal("(1.5,)", v)
+		v, err = Range[time.Time]{Lower: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), LowerInclusive: true, UpperUnbounded: true, Valid: true}.Value()
+		is.NoErr(err)
+		is.Equal(`["2020-01-01T00:00:00Z",)`, v)
+		v, err = Range[string]{Empty: true, Valid: true}.Value()
+		is.NoErr(err)
+		is.Equal("empty", v)
+		v, err = Range[int]{Lower: 1, Upper: 10}.Value()
+		is.NoErr(err)
+		is.Equal(nil, v)
+	})
+	t.Run("Scan", func(t *testing.T) {
+		is := is.New(t)
+		var times Range[time.Time]
+		err := times.Scan([]byte(`["2020-01-01 00:00:00+00","2020-01-02 12:30:00+08")`))
+		is.NoErr(err)
+		is.True(times.Lower.Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)))
+		is.True(times.Upper.Equal(time.Date(2020, 1, 2, 4, 30, 0, 0, time.UTC)))
+		is.True(times.LowerInclusive && !times.UpperInclusive)
+
+		var dates Range[time.Time]
+		is.NoErr(dates.Scan("[2020-01-01,)"))
+		is.Equal("2020-01-01", dates.Lower.Format("2006-01-02"))
+		is.True(dates.UpperUnbounded && !dates.LowerUnbounded)
+
+		var ints Range[int64]
+		is.NoErr(ints.Scan("(,5]"))
+		is.Equal(Range[int64]{Upper: 5, UpperInclusive: true, LowerUnbounded: true, Valid: true}, ints)
+		is.NoErr(ints.Scan("empty"))
+		is.Equal(Range[int64]{Empty: true, Valid: true}, ints)
+		is.NoErr(ints.Scan(nil))
+		is.Equal(Range[int64]{}, ints)
+		is.NoErr(ints.Scan("[0,0]"))
+		is.Equal(Range[int64]{LowerInclusive: true, UpperInclusive: true, Valid: true}, ints)
+
+		// time.Time cannot hold infinity, so it is only accepted where it means
+		// unbounded
+		is.NoErr(times.Scan("[-infinity,infinity)"))
+		is.Equal(Range[time.Time]{LowerInclusive: true, LowerUnbounded: true, UpperUnbounded: true, Valid: true}, times)
+		is.True(times.Scan("[infinity,)") != nil)
+		is.True(times.Scan(`("2020-01-01 00:00:00+00",-infinity)`) != nil)
+
+		var nums Range[string]
+		is.NoErr(nums.Scan(`[1.50,"2.25"]`))
+		is.Equal(Range[string]{Lower: "1.50", Upper: "2.25", LowerInclusive: true, UpperInclusive: true, Valid: true}, nums)
+
+		is.True(ints.Scan("[1,2") != nil)
+		is.True(ints.Scan("[a,2)") != nil)
+		is.True(ints.Scan(`["1,2)`) != nil)
+		is.True(ints.Scan(1) != nil)
+	})
+}
+
+func TestRow_Range(t *testing.T) {
+	if testing.Short() {
+		return
+	}
+	is := is.New(t)
+	db, err := sql.Open("txdb", "Row_Range")
+	is.NoErr(err)
+	defer db.Close()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	period := TSTZRange(start, end, "[)")
+	var times Range[time.Time]
+	var seats Range[int]
+	var contains, overlaps bool
+	err = SelectRowx(func(row *Row) {
+		row.Range(&times, period)
+		row.Range(&seats, Int4Range(1, 10, "[]"))
+		contains = row.Bool(period.Contains(start.Add(time.Hour)))
+		overlaps = row.Bool(period.Overlaps(Range[time.Time]{Lower: end, UpperUnbounded: true, LowerInclusive: true, Valid: true}))
+	}).Fetch(db)
+	is.NoErr(err)
+	is.True(times.Lower.Equal(start))
+	is.True(times.Upper.Equal(end))
+	is.Equal(Range[int]{Lower: 1, Upper: 11, LowerInclusive: true, Valid: true}, seats)
+	is.True(contains)
+	is.True(!overlaps)
+}
@@ -11,7 +11,6 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/lib/pq"
 )
 
 // ExitCode represents a reason for terminating the rows.Next() loop.
@@ -29,7 +28,8 @@ func (e ExitCode) Error() string {
 
 // Row represents the state of a row after a call to rows.Next().
 type Row struct {
-	rows    *sql.Rows
+	rows    rowsIterator
+	pgx     bool // if true, rows come from pgx and don't need lib/pq
 	index   int
 	fields  []Field
 	dest    []interface{}
@@ -111,12 +111,18 @@ func (r *Row) ScanInto(dest interface{}, field Field) {
 }
 
 // ScanArray accepts a pointer to a slice and scans a postgres array into it.
-// Only []bool, []float64, []int64 or []string slices are supported.
+// Slices of bools, numbers, strings, []byte, time.Time and sql.Scanners (such
+// as uuid.UUID) are supported, as are nested slices for multi-dimensional
+// arrays.
 func (r *Row) ScanArray(slice interface{}, field Field) {
 	var nothing interface{}
 	if r.rows == nil {
 		r.fields = append(r.fields, field)
-		r.dest = append(r.dest, pq.Array(slice))
+		if r.pgx {
+			r.dest = append(r.dest, slice)
+		} else {
+			r.dest = append(r.dest, scanArray(slice))
+		}
 		return
 	}
 	if len(r.tmpdest) != len(r.dest) {
@@ -125,7 +131,11 @@ func (r *Row) ScanArray(slice interface{}, field Field) {
 			r.tmpdest[i] = &nothing
 		}
 	}
-	r.tmpdest[r.index] = pq.Array(slice)
+	if r.pgx {
+		r.tmpdest[r.index] = slice
+	} else {
+		r.tmpdest[r.index] = scanArray(slice)
+	}
 	err := r.rows.Scan(r.tmpdest...)
 	if err != nil {
 		_, sourcefile, linenbr, _ := runtime.Caller(1)
@@ -158,6 +168,27 @@ func (r *Row) ScanJSON(dest interface{}, field Field) {
 	r.index++
 }
 
+// Range scans a postgres range into dest, which is usually a pointer to a
+// Range e.g. *Range[time.Time] for a tstzrange.
+func (r *Row) Range(dest sql.Scanner, field RangeField) {
+	rng, isPgxRange := dest.(pgxRange)
+	if r.rows == nil {
+		r.fields = append(r.fields, field)
+		if r.pgx && isPgxRange {
+			r.dest = append(r.dest, rng.newPgxRange())
+		} else {
+			r.dest = append(r.dest, reflect.New(reflect.TypeOf(dest).Elem()).Interface())
+		}
+		return
+	}
+	if r.pgx && isPgxRange {
+		rng.setPgxRange(r.dest[r.index])
+	} else {
+		reflect.ValueOf(dest).Elem().Set(reflect.ValueOf(r.dest[r.index]).Elem())
+	}
+	r.index++
+}
+
 /* bool */
 
 // Bool returns the bool value of the Predicate. BooleanFields are considered
@@ -370,6 +401,27 @@ func rowNullTime(r *Row, field Field) sql.NullTime {
 	return *nulltime
 }
 
+/* Interval */
+
+// Interval returns the Interval value of the IntervalField. A NULL interval
+// is returned as the zero Interval.
+func (r *Row) Interval(field IntervalField) Interval {
+	if r.rows == nil {
+		r.fields = append(r.fields, field)
+		r.dest = append(r.dest, &Interval{})
+		return Interval{}
+	}
+	interval := r.dest[r.index].(*Interval)
+	r.index++
+	return *interval
+}
+
+// Duration returns the value of the IntervalField as a time.Duration. Years,
+// months and days are converted the same way as Interval.TotalDuration.
+func (r *Row) Duration(field IntervalField) time.Duration {
+	return r.Interval(field).TotalDuration()
+}
+
 // UUID returns the [16]byte value of the UUIDField
 func (r *Row) UUID(field UUIDField) [16]byte {
 	if r.rows == nil {
@@ -0,0 +1,158 @@
+package sq
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestSelectQuery_Seek(t *testing.T) {
+	type TT struct {
+		description string
+		q           SelectQuery
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	u := USERS().As("u")
+	tests := []TT{
+		{
+			"no ORDER BY",
+			From(u).SeekAfter(1),
+			"SELECT FROM public.users AS u",
+			nil,
+		},
+		{
+			"no values",
+			From(u).OrderBy(u.USER_ID).SeekAfter(),
+			"SELECT FROM public.users AS u ORDER BY u.user_id",
+			nil,
+		},
+		{
+			"single field",
+			From(u).OrderBy(u.USER_ID.NullsFirst()).SeekAfter(5),
+			"SELECT FROM public.users AS u WHERE u.user_id > $1 ORDER BY u.user_id NULLS FIRST",
+			[]interface{}{5},
+		},
+		{
+			"single field, default NULLS ordering",
+			From(u).OrderBy(u.USER_ID).SeekAfter(5),
+			"SELECT FROM public.users AS u WHERE u.user_id > $1 OR u.user_id IS NULL ORDER BY u.user_id",
+			[]interface{}{5},
+		},
+		{
+			"mixed directions",
+			From(u).
+				Where(u.USER_ID.GtInt(0)).
+				OrderBy(u.DISPLAYNAME.Desc().NullsLast(), u.USER_ID.Asc().NullsFirst()).
+				SeekAfter("bob", 5),
+			"SELECT FROM public.users AS u WHERE u.user_id > $1 AND (" +
+				"(u.displayname < $2 OR u.displayname IS NULL)" +
+				" OR (u.displayname = $3 AND u.user_id > $4)" +
+				") ORDER BY u.displayname DESC NULLS LAST, u.user_id ASC NULLS FIRST",
+			[]interface{}{0, "bob", "bob", 5},
+		},
+		{
+			"SeekBefore",
+			From(u).
+				OrderBy(u.DISPLAYNAME.Desc().NullsLast(), u.USER_ID.Asc().NullsFirst()).
+				SeekBefore("bob", 5),
+			"SELECT FROM public.users AS u WHERE u.displayname > $1" +
+				" OR (u.displayname = $2 AND (u.user_id < $3 OR u.user_id IS NULL))" +
+				" ORDER BY u.displayname DESC NULLS LAST, u.user_id ASC NULLS FIRST",
+			[]interface{}{"bob", "bob", 5},
+		},
+		{
+			"NULL cursor values",
+			From(u).
+				OrderBy(u.EMAIL.NullsFirst(), u.DISPLAYNAME.NullsLast(), u.USER_ID).
+				SeekAfter(nil, sql.NullString{}, 5),
+			"SELECT FROM public.users AS u WHERE u.email IS NOT NULL" +
+				" OR (u.email IS NULL AND u.displayname IS NULL AND (u.user_id > $1 OR u.user_id IS NULL))" +
+				" ORDER BY u.email NULLS FIRST, u.displayname NULLS LAST, u.user_id",
+			[]interface{}{5},
+		},
+		{
+			"nothing after",
+			From(u).OrderBy(u.USER_ID.NullsLast()).SeekAfter(nil),
+			"SELECT FROM public.users AS u WHERE FALSE ORDER BY u.user_id NULLS LAST",
+			nil,
+		},
+		{
+			"extra values are ignored",
+			From(u).OrderBy(Fieldf("LOWER(?)", u.EMAIL).Desc().NullsFirst()).SeekAfter("bob@email.com", 5),
+			"SELECT FROM public.users AS u WHERE LOWER(u.email) < $1 ORDER BY LOWER(u.email) DESC NULLS FIRST",
+			[]interface{}{"bob@email.com"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			gotQuery, gotArgs := tt.q.ToSQL()
+			is.Equal(tt.wantQuery, gotQuery)
+			is.Equal(tt.wantArgs, gotArgs)
+		})
+	}
+}
+
+func TestSelectQuery_SeekFetch(t *testing.T) {
+	if testing.Short() {
+		return
+	}
+	is := is.New(t)
+	db, err := sql.Open("txdb", "SelectQuery_SeekFetch")
+	is.NoErr(err)
+	defer db.Close()
+	u := USERS()
+	key := []byte("secret")
+	q := WithDB(db).From(u).OrderBy(u.DISPLAYNAME.Desc(), u.USER_ID)
+
+	var want []int
+	var wantDisplaynames []string
+	var displayname string
+	var userID int
+	mapper := func(row *Row) {
+		displayname = row.String(u.DISPLAYNAME)
+		userID = row.Int(u.USER_ID)
+	}
+	err = q.Selectx(mapper, func() {
+		want = append(want, userID)
+		wantDisplaynames = append(wantDisplaynames, displayname)
+	}).Fetch(nil)
+	is.NoErr(err)
+	is.True(len(want) > 10)
+
+	// Page through the table with cursor tokens
+	var got []int
+	var token string
+	for {
+		page := q.Limit(7)
+		if token != "" {
+			values, err := DecodeCursor(key, token)
+			is.NoErr(err)
+			page = page.SeekAfter(values...)
+		}
+		var count int
+		err = page.Selectx(mapper, func() {
+			got = append(got, userID)
+			count++
+		}).Fetch(nil)
+		is.NoErr(err)
+		if count == 0 {
+			break
+		}
+		token, err = EncodeCursor(key, displayname, userID)
+		is.NoErr(err)
+	}
+	is.Equal(want, got)
+
+	// SeekBefore
+	var before []int
+	err = q.SeekBefore(wantDisplaynames[5], want[5]).Selectx(mapper, func() {
+		before = append(before, userID)
+	}).Fetch(nil)
+	is.NoErr(err)
+	is.Equal(want[:5], before)
+}
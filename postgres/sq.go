@@ -38,6 +38,23 @@ func getAliasOrName(val interface {
 	return s
 }
 
+// appendTableAlias writes the ' AS alias' of a table in a FROM or JOIN clause.
+// Set-returning functions like Unnest and GenerateSeries also name their
+// columns after the alias i.e. ' AS alias(col1, col2)'.
+func appendTableAlias(buf *strings.Builder, table Table) {
+	alias := table.GetAlias()
+	if alias == "" {
+		return
+	}
+	buf.WriteString(" AS ")
+	buf.WriteString(alias)
+	if tbl, ok := table.(interface {
+		appendColumnAliases(buf *strings.Builder)
+	}); ok {
+		tbl.appendColumnAliases(buf)
+	}
+}
+
 // Query is an interface that specialises the Table interface. It covers only
 // queries like SELECT/INSERT/UPDATE/DELETE.
 type Query interface {
@@ -299,3 +299,45 @@ func TestStringField_Predicates(t *testing.T) {
 		})
 	}
 }
+
+func TestStringField_Functions(t *testing.T) {
+	type TT struct {
+		description string
+		f           Field
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	tbl := &TableInfo{Schema: "public", Name: "users"}
+	name := NewStringField("name", tbl)
+	email := NewStringField("email", tbl)
+	tests := []TT{
+		{"Lower", email.Lower(), "LOWER(users.email)", nil},
+		{"Upper Trim", name.Trim().Upper(), "UPPER(TRIM(users.name))", nil},
+		{"Concat", name.Concat(" <", email, ">"), "users.name || ? || users.email || ?", []interface{}{" <", ">"}},
+		{"Substring", name.Substring(2, 3), "SUBSTRING(users.name FROM 2 FOR 3)", nil},
+		{"Length", name.Length(), "LENGTH(users.name)", nil},
+		{"Replace", email.Replace("@", " at "), "REPLACE(users.email, ?, ?)", []interface{}{"@", " at "}},
+		{"Left", name.Left(1), "LEFT(users.name, 1)", nil},
+		{"Right", name.Right(2), "RIGHT(users.name, 2)", nil},
+		{"Collate", name.Collate("C").Asc(), `users.name COLLATE "C" ASC`, nil},
+		{"Collate expression", name.Lower().Collate(`en"US`), `(LOWER(users.name)) COLLATE "en""US"`, nil},
+		{"Coalesce", name.Coalesce(email, "anonymous"), "COALESCE(users.name, users.email, ?)", []interface{}{"anonymous"}},
+		{"NullIf", name.NullIf(""), "NULLIF(users.name, ?)", []interface{}{""}},
+		{"RegexpMatch", email.RegexpMatch(`^\w+@`), "users.email ~ ?", []interface{}{`^\w+@`}},
+		{"RegexpIMatch", email.RegexpIMatch("EXAMPLE"), "users.email ~* ?", []interface{}{"EXAMPLE"}},
+		{"SimilarTo", name.SimilarTo("%(b|d)%"), "users.name SIMILAR TO ?", []interface{}{"%(b|d)%"}},
+		{"StartsWith", email.StartsWith(`50%_off\`), "users.email LIKE ?", []interface{}{`50\%\_off\\%`}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.f.AppendSQLExclude(buf, &args, nil, nil)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
@@ -44,6 +44,14 @@ func (tbl *TableInfo) GetAlias() string {
 	return tbl.Alias
 }
 
+// GetSchema returns the schema from the TableInfo.
+func (tbl *TableInfo) GetSchema() string {
+	if tbl == nil {
+		return ""
+	}
+	return tbl.Schema
+}
+
 // GetName implements the Table interface. It returns the name from the
 // TableInfo.
 func (tbl *TableInfo) GetName() string {
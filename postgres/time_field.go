@@ -5,18 +5,28 @@ import (
 	"time"
 )
 
-// TimeField either represents a time column or a literal time.Time value.
+// TimeField either represents a time column, a time expression or a literal
+// time.Time value.
 type TimeField struct {
 	// TimeField will be one of the following:
 
-	// 1) Literal time.Time value
+	// 1) Time expression
+	// Examples of time expressions:
+	// | query                                 | args |
+	// |---------------------------------------|------|
+	// | users.created_at + INTERVAL '30 days' |      |
+	// | date_trunc('day', events.start_at)    |      |
+	format *string
+	values []interface{}
+
+	// 2) Literal time.Time value
 	// Examples of literal string values:
 	// | query | args       |
 	// |-------|------------|
 	// | ?     | time.Now() |
 	value *time.Time
 
-	// 2) Time column
+	// 3) Time column
 	// Examples of time columns:
 	// | query            | args |
 	// |------------------|------|
@@ -34,12 +44,15 @@ type TimeField struct {
 // in the TimeField internal struct comments.
 func (f TimeField) AppendSQLExclude(buf *strings.Builder, args *[]interface{}, params map[string]int, excludedTableQualifiers []string) {
 	switch {
+	case f.format != nil:
+		// 1) Time expression
+		expandValues(buf, args, excludedTableQualifiers, *f.format, f.values)
 	case f.value != nil:
-		// 1) Literal time.Time value
+		// 2) Literal time.Time value
 		buf.WriteString("?")
 		*args = append(*args, *f.value)
 	default:
-		// 2) Time column
+		// 3) Time column
 		tableQualifier := f.table.GetAlias()
 		if tableQualifier == "" {
 			tableQualifier = f.table.GetName()
@@ -155,6 +168,13 @@ func (f TimeField) NullsLast() TimeField {
 	return f
 }
 
+// ordering implements the orderedField interface.
+func (f TimeField) ordering() (field Field, descending, nullsfirst *bool) {
+	descending, nullsfirst = f.descending, f.nullsfirst
+	f.descending, f.nullsfirst = nil, nil
+	return f, descending, nullsfirst
+}
+
 // IsNull returns an 'X IS NULL' Predicate.
 func (f TimeField) IsNull() Predicate {
 	return CustomPredicate{
@@ -320,6 +340,183 @@ func (f TimeField) NotBetweenSymmetricTime(start, end time.Time) Predicate {
 	}
 }
 
+// EqAny returns an 'X = ANY(Y)' Predicate, which is true if the TimeField is
+// equal to any element of the ArrayField.
+func (f TimeField) EqAny(array ArrayField) Predicate {
+	return CustomPredicate{
+		Format: "? = ANY(?)",
+		Values: []interface{}{f, array},
+	}
+}
+
+// NeAll returns an 'X <> ALL(Y)' Predicate, which is true if the TimeField is
+// not equal to every element of the ArrayField.
+func (f TimeField) NeAll(array ArrayField) Predicate {
+	return CustomPredicate{
+		Format: "? <> ALL(?)",
+		Values: []interface{}{f, array},
+	}
+}
+
+// AddInterval returns a new TimeField that is the TimeField plus the Interval
+// e.g. field + INTERVAL '1 day'.
+//
+//	u.CREATED_AT.AddInterval(Interval{Days: 30})
+//	u.CREATED_AT.AddInterval(Interval{Duration: 90 * time.Minute})
+func (f TimeField) AddInterval(interval Interval) TimeField {
+	format := "? + INTERVAL " + quoteLiteral(interval.String())
+	return TimeField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// SubInterval returns a new TimeField that is the TimeField minus the Interval
+// e.g. field - INTERVAL '1 day'.
+func (f TimeField) SubInterval(interval Interval) TimeField {
+	format := "? - INTERVAL " + quoteLiteral(interval.String())
+	return TimeField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// DateTrunc returns a new TimeField truncated to the precision of the unit
+// e.g. 'year', 'month', 'week', 'day', 'hour' i.e. 'date_trunc('day', field)'.
+func (f TimeField) DateTrunc(unit string) TimeField {
+	format := "date_trunc(" + quoteLiteral(unit) + ", ?)"
+	return TimeField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Extract returns a subfield of the TimeField e.g. 'year', 'month', 'day',
+// 'dow', 'epoch' as a NumberField i.e. 'EXTRACT(DAY FROM field)'.
+func (f TimeField) Extract(field string) NumberField {
+	var format string
+	if isIdentifier(field) {
+		format = "EXTRACT(" + strings.ToUpper(field) + " FROM ?)"
+	} else {
+		format = "EXTRACT(" + quoteLiteral(field) + " FROM ?)"
+	}
+	return NumberField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// DatePart returns a subfield of the TimeField as a NumberField i.e.
+// 'date_part('day', field)'. It is the same as Extract except that it returns
+// a double precision instead of a numeric.
+func (f TimeField) DatePart(field string) NumberField {
+	format := "date_part(" + quoteLiteral(field) + ", ?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// AtTimeZone converts the TimeField to the time zone e.g. field AT TIME ZONE
+// 'Asia/Singapore'. A timestamptz becomes the local timestamp in that zone,
+// while a timestamp is taken to be in that zone and becomes a timestamptz.
+func (f TimeField) AtTimeZone(zone string) TimeField {
+	format := "? AT TIME ZONE " + quoteLiteral(zone)
+	if f.format != nil {
+		format = "(?) AT TIME ZONE " + quoteLiteral(zone)
+	}
+	return TimeField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Add returns a new TimeField that is the TimeField plus the IntervalField
+// i.e. 'field + interval'.
+func (f TimeField) Add(interval IntervalField) TimeField {
+	format := "? + " + interval.operand()
+	return TimeField{
+		format: &format,
+		values: []interface{}{f, interval},
+	}
+}
+
+// Sub returns a new TimeField that is the TimeField minus the IntervalField
+// i.e. 'field - interval'.
+func (f TimeField) Sub(interval IntervalField) TimeField {
+	format := "? - " + interval.operand()
+	return TimeField{
+		format: &format,
+		values: []interface{}{f, interval},
+	}
+}
+
+// SubTime returns the interval from the other TimeField to the TimeField in
+// days, hours, minutes and seconds i.e. 'field - other'.
+func (f TimeField) SubTime(other TimeField) IntervalField {
+	format := "? - ?"
+	if other.format != nil {
+		format = "? - (?)"
+	}
+	return IntervalField{
+		format: &format,
+		values: []interface{}{f, other},
+	}
+}
+
+// Age returns the interval between the TimeField and the other TimeField in
+// years, months and days i.e. 'age(field, other)'.
+func (f TimeField) Age(other TimeField) IntervalField {
+	format := "age(?, ?)"
+	return IntervalField{
+		format: &format,
+		values: []interface{}{f, other},
+	}
+}
+
+// DateDiff returns the number of days from the other TimeField to the
+// TimeField, ignoring the time of day i.e. 'CAST(field AS DATE) - CAST(other
+// AS DATE)'.
+func (f TimeField) DateDiff(other TimeField) NumberField {
+	format := "CAST(? AS DATE) - CAST(? AS DATE)"
+	return NumberField{
+		format:   &format,
+		values:   []interface{}{f, other},
+		operator: operatorAdditive,
+	}
+}
+
+// Now returns the start time of the current transaction as a TimeField i.e.
+// 'NOW()'.
+func Now() TimeField {
+	format := "NOW()"
+	return TimeField{
+		format: &format,
+	}
+}
+
+// CurrentDate returns the current date as a TimeField i.e. 'CURRENT_DATE'.
+func CurrentDate() TimeField {
+	format := "CURRENT_DATE"
+	return TimeField{
+		format: &format,
+	}
+}
+
+// isIdentifier reports whether s only consists of letters, digits and
+// underscores.
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if !(c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')) {
+			return false
+		}
+	}
+	return true
+}
+
 // String implements the fmt.Stringer interface. It returns the string
 // representation of a TimeField.
 func (f TimeField) String() string {
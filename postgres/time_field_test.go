@@ -317,3 +317,51 @@ func TestTimeField_Predicates(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeField_Functions(t *testing.T) {
+	type TT struct {
+		description string
+		f           Field
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	tbl := &TableInfo{Schema: "public", Name: "users"}
+	createdAt := NewTimeField("created_at", tbl)
+	updatedAt := NewTimeField("updated_at", tbl)
+	tests := []TT{
+		{"AddInterval days", createdAt.AddInterval(Interval{Days: 30}), "users.created_at + INTERVAL '30 days'", nil},
+		{
+			"AddInterval mixed",
+			createdAt.AddInterval(Interval{Years: 1, Months: 2, Days: 1, Duration: 4*time.Hour + 5*time.Minute + 6*time.Second + 500*time.Millisecond}),
+			"users.created_at + INTERVAL '1 year 2 mons 1 day 04:05:06.5'",
+			nil,
+		},
+		{"SubInterval duration", Now().SubInterval(Interval{Duration: -90 * time.Minute}), "NOW() - INTERVAL '-01:30:00'", nil},
+		{"SubInterval zero", CurrentDate().SubInterval(Interval{}), "CURRENT_DATE - INTERVAL '00:00:00'", nil},
+		{"DateTrunc", createdAt.DateTrunc("day").Desc(), "date_trunc('day', users.created_at) DESC", nil},
+		{"Extract", createdAt.Extract("dow"), "EXTRACT(DOW FROM users.created_at)", nil},
+		{"Extract quoted", createdAt.Extract("it's"), "EXTRACT('it''s' FROM users.created_at)", nil},
+		{"DatePart", createdAt.DatePart("epoch"), "date_part('epoch', users.created_at)", nil},
+		{"AtTimeZone", createdAt.AtTimeZone("Asia/Singapore"), "users.created_at AT TIME ZONE 'Asia/Singapore'", nil},
+		{
+			"AtTimeZone expression",
+			createdAt.AddInterval(Interval{Days: 1}).AtTimeZone("UTC"),
+			"(users.created_at + INTERVAL '1 day') AT TIME ZONE 'UTC'",
+			nil,
+		},
+		{"Age", updatedAt.Age(createdAt), "age(users.updated_at, users.created_at)", nil},
+		{"DateDiff", updatedAt.DateDiff(createdAt), "CAST(users.updated_at AS DATE) - CAST(users.created_at AS DATE)", nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.f.AppendSQLExclude(buf, &args, nil, nil)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
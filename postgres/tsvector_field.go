@@ -0,0 +1,318 @@
+package sq
+
+import "strings"
+
+// TSVectorField either represents a TSVECTOR column or a tsvector expression.
+type TSVectorField struct {
+	// TSVectorField will be one of the following:
+
+	// 1) tsvector expression
+	// Examples of tsvector expressions:
+	// | query                                  | args  |
+	// |----------------------------------------|-------|
+	// | to_tsvector('english', ?)              | hello |
+	// | setweight(to_tsvector(tbl.title), 'A') |       |
+	format *string
+	values []interface{}
+
+	// 2) TSVECTOR column
+	// Examples of tsvector columns:
+	// | query             | args |
+	// |-------------------|------|
+	// | posts.search_text |      |
+	// | search_text       |      |
+	alias string
+	table Table
+	name  string
+}
+
+// AppendSQLExclude marshals the TSVectorField into a buffer and an args slice.
+// It will not table qualify itself if its table qualifer appears in the
+// excludedTableQualifiers list.
+func (f TSVectorField) AppendSQLExclude(buf *strings.Builder, args *[]interface{}, params map[string]int, excludedTableQualifiers []string) {
+	switch {
+	case f.format != nil:
+		// 1) tsvector expression
+		expandValues(buf, args, excludedTableQualifiers, *f.format, f.values)
+	default:
+		// 2) TSVECTOR column
+		tableQualifier := f.table.GetAlias()
+		if tableQualifier == "" {
+			tableQualifier = f.table.GetName()
+		}
+		for _, excludedTableQualifier := range excludedTableQualifiers {
+			if tableQualifier == excludedTableQualifier {
+				tableQualifier = ""
+				break
+			}
+		}
+		if tableQualifier != "" {
+			if strings.ContainsAny(tableQualifier, " \t") {
+				buf.WriteString(`"`)
+				buf.WriteString(tableQualifier)
+				buf.WriteString(`".`)
+			} else {
+				buf.WriteString(tableQualifier)
+				buf.WriteString(".")
+			}
+		}
+		if strings.ContainsAny(f.name, " \t") {
+			buf.WriteString(`"`)
+			buf.WriteString(f.name)
+			buf.WriteString(`"`)
+		} else {
+			buf.WriteString(f.name)
+		}
+	}
+}
+
+// NewTSVectorField returns a new TSVectorField representing a TSVECTOR column.
+func NewTSVectorField(name string, table Table) TSVectorField {
+	return TSVectorField{
+		name:  name,
+		table: table,
+	}
+}
+
+// quoteLiteral returns the string as an SQL string literal e.g. 'english'.
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// textSearchFunc returns the format for calling a text search function with an
+// optional configuration and a single argument e.g. 'to_tsvector('english',
+// ?)'. The configuration is written as a literal instead of an arg because
+// postgres only uses an expression index if the configuration is a constant.
+func textSearchFunc(name, config string) string {
+	if config == "" {
+		return name + "(?)"
+	}
+	return name + "(" + quoteLiteral(config) + ", ?)"
+}
+
+// ToTSVector returns a TSVectorField that converts the document to a tsvector
+// using the text search configuration i.e. 'to_tsvector(config, document)'.
+// The document may be a StringField, a JSONField or a string. If the config is
+// empty the default_text_search_config of the database is used.
+func ToTSVector(config string, document interface{}) TSVectorField {
+	format := textSearchFunc("to_tsvector", config)
+	return TSVectorField{
+		format: &format,
+		values: []interface{}{document},
+	}
+}
+
+// Set returns a FieldAssignment associating the TSVectorField to the value
+// i.e. 'field = value'.
+func (f TSVectorField) Set(value interface{}) FieldAssignment {
+	return FieldAssignment{
+		Field: f,
+		Value: value,
+	}
+}
+
+// As returns a new TSVectorField with the new field Alias i.e. 'field AS
+// Alias'.
+func (f TSVectorField) As(alias string) TSVectorField {
+	f.alias = alias
+	return f
+}
+
+// IsNull returns an 'X IS NULL' Predicate.
+func (f TSVectorField) IsNull() Predicate {
+	return CustomPredicate{
+		Format: "? IS NULL",
+		Values: []interface{}{f},
+	}
+}
+
+// IsNotNull returns an 'X IS NOT NULL' Predicate.
+func (f TSVectorField) IsNotNull() Predicate {
+	return CustomPredicate{
+		Format: "? IS NOT NULL",
+		Values: []interface{}{f},
+	}
+}
+
+// Matches returns an 'X @@ Y' Predicate, which is true if the TSVectorField
+// matches the TSQueryField.
+func (f TSVectorField) Matches(query TSQueryField) Predicate {
+	return CustomPredicate{
+		Format: "? @@ ?",
+		Values: []interface{}{f, query},
+	}
+}
+
+// Concat concatenates the object TSVectorField to the subject TSVectorField
+// i.e. 'X || Y'.
+func (f TSVectorField) Concat(field TSVectorField) TSVectorField {
+	format := "? || ?"
+	return TSVectorField{
+		format: &format,
+		values: []interface{}{f, field},
+	}
+}
+
+// Weight labels every lexeme in the TSVectorField with the weight, which is
+// one of A, B, C or D i.e. 'setweight(field, weight)'. Weights are taken into
+// account by TSRank and TSRankCD.
+func (f TSVectorField) Weight(weight string) TSVectorField {
+	format := "setweight(?, " + quoteLiteral(weight) + ")"
+	return TSVectorField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// String implements the fmt.Stringer interface. It returns the string
+// representation of a TSVectorField.
+func (f TSVectorField) String() string {
+	buf := &strings.Builder{}
+	var args []interface{}
+	f.AppendSQLExclude(buf, &args, nil, nil)
+	return questionInterpolate(buf.String(), args...)
+}
+
+// GetAlias implements the Field interface. It returns the Alias of the
+// TSVectorField.
+func (f TSVectorField) GetAlias() string {
+	return f.alias
+}
+
+// GetName implements the Field interface. It returns the Name of the
+// TSVectorField.
+func (f TSVectorField) GetName() string {
+	return f.name
+}
+
+// TSQueryField represents a tsquery expression, created by one of ToTSQuery,
+// PlainToTSQuery, PhraseToTSQuery or WebSearchToTSQuery.
+type TSQueryField struct {
+	alias  string
+	format string
+	values []interface{}
+}
+
+// AppendSQLExclude marshals the TSQueryField into a buffer and an args slice.
+func (f TSQueryField) AppendSQLExclude(buf *strings.Builder, args *[]interface{}, params map[string]int, excludedTableQualifiers []string) {
+	expandValues(buf, args, excludedTableQualifiers, f.format, f.values)
+}
+
+// ToTSQuery returns a TSQueryField that parses the query, which must already
+// be written in tsquery syntax e.g. 'fat & (rat | cat)', i.e.
+// 'to_tsquery(config, query)'.
+func ToTSQuery(config string, query interface{}) TSQueryField {
+	return TSQueryField{
+		format: textSearchFunc("to_tsquery", config),
+		values: []interface{}{query},
+	}
+}
+
+// PlainToTSQuery returns a TSQueryField that matches every word in the query
+// i.e. 'plainto_tsquery(config, query)'. Punctuation in the query is ignored.
+func PlainToTSQuery(config string, query interface{}) TSQueryField {
+	return TSQueryField{
+		format: textSearchFunc("plainto_tsquery", config),
+		values: []interface{}{query},
+	}
+}
+
+// PhraseToTSQuery returns a TSQueryField that matches the words in the query
+// as a phrase i.e. 'phraseto_tsquery(config, query)'.
+func PhraseToTSQuery(config string, query interface{}) TSQueryField {
+	return TSQueryField{
+		format: textSearchFunc("phraseto_tsquery", config),
+		values: []interface{}{query},
+	}
+}
+
+// WebSearchToTSQuery returns a TSQueryField that parses the query the way a
+// web search engine would i.e. 'websearch_to_tsquery(config, query)'. Quoted
+// text is matched as a phrase, 'or' matches either side and a leading '-'
+// excludes a word. It never raises a syntax error, so it is safe to use on raw
+// user input.
+func WebSearchToTSQuery(config string, query interface{}) TSQueryField {
+	return TSQueryField{
+		format: textSearchFunc("websearch_to_tsquery", config),
+		values: []interface{}{query},
+	}
+}
+
+// And returns a TSQueryField that matches both TSQueryFields i.e. 'X && Y'.
+func (f TSQueryField) And(query TSQueryField) TSQueryField {
+	return TSQueryField{
+		format: "(? && ?)",
+		values: []interface{}{f, query},
+	}
+}
+
+// Or returns a TSQueryField that matches either TSQueryField i.e. 'X || Y'.
+func (f TSQueryField) Or(query TSQueryField) TSQueryField {
+	return TSQueryField{
+		format: "(? || ?)",
+		values: []interface{}{f, query},
+	}
+}
+
+// As returns a new TSQueryField with the new field Alias i.e. 'field AS
+// Alias'.
+func (f TSQueryField) As(alias string) TSQueryField {
+	f.alias = alias
+	return f
+}
+
+// String implements the fmt.Stringer interface. It returns the string
+// representation of a TSQueryField.
+func (f TSQueryField) String() string {
+	buf := &strings.Builder{}
+	var args []interface{}
+	f.AppendSQLExclude(buf, &args, nil, nil)
+	return questionInterpolate(buf.String(), args...)
+}
+
+// GetAlias implements the Field interface. It returns the Alias of the
+// TSQueryField.
+func (f TSQueryField) GetAlias() string {
+	return f.alias
+}
+
+// GetName implements the Field interface. It returns the Name of the
+// TSQueryField.
+func (f TSQueryField) GetName() string {
+	return ""
+}
+
+// TSRank ranks how well the TSVectorField matches the TSQueryField based on
+// the frequency of the matching lexemes i.e. 'ts_rank(vector, query)'. Use it
+// to order search results e.g. OrderBy(TSRank(vector, query).Desc()).
+func TSRank(vector TSVectorField, query TSQueryField) NumberField {
+	return NumberFieldf("ts_rank(?, ?)", vector, query)
+}
+
+// TSRankCD ranks how well the TSVectorField matches the TSQueryField based on
+// the cover density of the matching lexemes i.e. 'ts_rank_cd(vector, query)'.
+// The TSVectorField must contain positional information, which to_tsvector
+// includes.
+func TSRankCD(vector TSVectorField, query TSQueryField) NumberField {
+	return NumberFieldf("ts_rank_cd(?, ?)", vector, query)
+}
+
+// TSHeadline returns an excerpt of the document with the terms matching the
+// TSQueryField highlighted i.e. 'ts_headline(config, document, query,
+// options)'. The options is a comma separated list of option=value pairs e.g.
+// 'StartSel=<b>, StopSel=</b>, MaxWords=35', and is left out if it is empty.
+func TSHeadline(config string, document interface{}, query TSQueryField, options string) StringField {
+	format := "ts_headline("
+	if config != "" {
+		format += quoteLiteral(config) + ", "
+	}
+	format += "?, ?"
+	if options != "" {
+		format += ", " + quoteLiteral(options)
+	}
+	format += ")"
+	return StringField{
+		format: &format,
+		values: []interface{}{document, query},
+	}
+}
@@ -0,0 +1,133 @@
+package sq
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestTSVectorField_AppendSQLExclude(t *testing.T) {
+	type TT struct {
+		description string
+		f           Field
+		exclude     []string
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	tbl := &TableInfo{Schema: "public", Name: "posts"}
+	vector := NewTSVectorField("search_text", tbl)
+	tests := []TT{
+		{"table qualified", vector, nil, "posts.search_text", nil},
+		{"excluded", vector, []string{"posts"}, "search_text", nil},
+		{
+			"ToTSVector",
+			ToTSVector("english", NewStringField("body", tbl)),
+			nil,
+			"to_tsvector('english', posts.body)",
+			nil,
+		},
+		{
+			"ToTSVector without config",
+			ToTSVector("", "the quick brown fox"),
+			nil,
+			"to_tsvector(?)",
+			[]interface{}{"the quick brown fox"},
+		},
+		{
+			"Weight and Concat",
+			ToTSVector("english", NewStringField("title", tbl)).Weight("A").
+				Concat(ToTSVector("english", NewStringField("body", tbl)).Weight("B")),
+			nil,
+			"setweight(to_tsvector('english', posts.title), 'A') || setweight(to_tsvector('english', posts.body), 'B')",
+			nil,
+		},
+		{
+			"config is escaped",
+			ToTSQuery("it's", "fat & rat"),
+			nil,
+			"to_tsquery('it''s', ?)",
+			[]interface{}{"fat & rat"},
+		},
+		{
+			"And Or",
+			PlainToTSQuery("english", "fat rat").And(PhraseToTSQuery("english", "fat cat")).Or(ToTSQuery("", "dog")),
+			nil,
+			"((plainto_tsquery('english', ?) && phraseto_tsquery('english', ?)) || to_tsquery(?))",
+			[]interface{}{"fat rat", "fat cat", "dog"},
+		},
+		{
+			"Matches",
+			vector.Matches(WebSearchToTSQuery("english", `"fat rat" -cat`)),
+			nil,
+			"posts.search_text @@ websearch_to_tsquery('english', ?)",
+			[]interface{}{`"fat rat" -cat`},
+		},
+		{
+			"TSRank",
+			TSRank(vector, ToTSQuery("english", "rat")),
+			nil,
+			"ts_rank(posts.search_text, to_tsquery('english', ?))",
+			[]interface{}{"rat"},
+		},
+		{
+			"TSRankCD",
+			TSRankCD(vector, ToTSQuery("english", "rat")).Desc(),
+			nil,
+			"ts_rank_cd(posts.search_text, to_tsquery('english', ?)) DESC",
+			[]interface{}{"rat"},
+		},
+		{
+			"TSHeadline",
+			TSHeadline("english", NewStringField("body", tbl), PlainToTSQuery("english", "rat"), "StartSel=<b>, StopSel=</b>"),
+			nil,
+			"ts_headline('english', posts.body, plainto_tsquery('english', ?), 'StartSel=<b>, StopSel=</b>')",
+			[]interface{}{"rat"},
+		},
+		{
+			"TSHeadline without config and options",
+			TSHeadline("", "a fat rat", PlainToTSQuery("", "rat"), ""),
+			nil,
+			"ts_headline(?, plainto_tsquery(?))",
+			[]interface{}{"a fat rat", "rat"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.f.AppendSQLExclude(buf, &args, nil, tt.exclude)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
+
+func TestTSVectorField_Search(t *testing.T) {
+	if testing.Short() {
+		return
+	}
+	is := is.New(t)
+	db, err := sql.Open("txdb", "TSVectorField_Search")
+	is.NoErr(err)
+	defer db.Close()
+	doc := String("The quick brown fox jumps over the lazy dog")
+	vector := ToTSVector("english", doc)
+	query := WebSearchToTSQuery("english", "jumping fox")
+	var matches bool
+	var rank float64
+	var headline string
+	err = SelectRowx(func(row *Row) {
+		matches = row.Bool(vector.Matches(query))
+		rank = row.Float64(TSRank(vector, query))
+		headline = row.String(TSHeadline("english", doc, query, "StartSel=[, StopSel=]"))
+	}).Fetch(db)
+	is.NoErr(err)
+	is.True(matches)
+	is.True(rank > 0)
+	is.Equal("The quick brown [fox] [jumps] over the lazy dog", headline)
+}
@@ -0,0 +1,187 @@
+package sq
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+)
+
+// TxOptions holds the transaction options used by Tx.
+type TxOptions struct {
+	Isolation sql.IsolationLevel
+	ReadOnly  bool
+	// MaxRetries is the number of times the transaction will be retried if it
+	// fails with a serialization failure or a deadlock (SQLSTATE 40001 or
+	// 40P01). The function passed to Tx must be safe to run more than once if
+	// MaxRetries is non-zero. Only the outermost Tx is retried: nested calls
+	// return the error to the outermost Tx.
+	MaxRetries int
+}
+
+// txBeginner is a DB that can start a transaction, e.g. *sql.DB.
+type txBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// pgxTxBeginner is a PgxConn that can start a transaction, e.g. *pgx.Conn,
+// *pgxpool.Pool or *pgxpool.Conn.
+type pgxTxBeginner interface {
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+// txn is a transaction started by Tx.
+type txn struct {
+	db       DB
+	commit   func() error
+	rollback func() error
+}
+
+var savepointCount uint64
+
+// Tx runs fn inside a transaction, passing in a DB that runs queries in that
+// transaction. If fn returns nil the transaction is committed, otherwise it is
+// rolled back and the error is returned. If fn panics the transaction is
+// rolled back before the panic continues.
+//
+// If db is already a transaction (a *sql.Tx, a PgxDB wrapping a pgx.Tx or the
+// DB passed to fn by an enclosing Tx) then a SAVEPOINT is used instead: it is
+// released if fn returns nil, and rolled back to otherwise. The opts are
+// ignored for nested calls. Any other DB that cannot begin a transaction is an
+// error, since a SAVEPOINT outside of a transaction does not make fn atomic.
+func Tx(ctx context.Context, db DB, opts *TxOptions, fn func(tx DB) error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opts == nil {
+		opts = &TxOptions{}
+	}
+	var begin func() (txn, error)
+	if pgxDB, ok := asPgxDB(db); ok {
+		switch conn := pgxDB.Conn.(type) {
+		case pgx.Tx:
+			return savepoint(ctx, pgxDB, fn)
+		case pgxTxBeginner:
+			begin = func() (txn, error) {
+				tx, err := conn.BeginTx(ctx, pgxTxOptions(opts))
+				if err != nil {
+					return txn{}, err
+				}
+				return txn{
+					db:       PgxDB{Conn: tx},
+					commit:   func() error { return tx.Commit(ctx) },
+					rollback: func() error { return tx.Rollback(ctx) },
+				}, nil
+			}
+		}
+	} else {
+		switch v := db.(type) {
+		case txBeginner:
+			begin = func() (txn, error) {
+				tx, err := v.BeginTx(ctx, &sql.TxOptions{Isolation: opts.Isolation, ReadOnly: opts.ReadOnly})
+				if err != nil {
+					return txn{}, err
+				}
+				return txn{db: tx, commit: tx.Commit, rollback: tx.Rollback}, nil
+			}
+		case *sql.Tx:
+			return savepoint(ctx, v, fn)
+		}
+	}
+	if begin == nil {
+		return fmt.Errorf("%T can neither begin a transaction nor is it a transaction", db)
+	}
+	for attempt := 0; ; attempt++ {
+		err := runTx(begin, fn)
+		if err == nil || attempt >= opts.MaxRetries || !isRetryableTxError(err) {
+			return err
+		}
+	}
+}
+
+// runTx runs fn inside a new transaction, committing it if fn returns nil and
+// rolling it back otherwise.
+func runTx(begin func() (txn, error), fn func(tx DB) error) (err error) {
+	tx, err := begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			_ = tx.rollback()
+			panic(r)
+		}
+	}()
+	err = fn(tx.db)
+	if err != nil {
+		_ = tx.rollback()
+		return err
+	}
+	return tx.commit()
+}
+
+// savepoint runs fn inside a new SAVEPOINT, releasing it if fn returns nil and
+// rolling back to it otherwise.
+func savepoint(ctx context.Context, db DB, fn func(tx DB) error) (err error) {
+	name := "sq_savepoint_" + strconv.FormatUint(atomic.AddUint64(&savepointCount, 1), 10)
+	_, err = db.ExecContext(ctx, "SAVEPOINT "+name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			_, _ = db.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+			panic(r)
+		}
+	}()
+	err = fn(db)
+	if err != nil {
+		_, rollbackErr := db.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+		if rollbackErr != nil {
+			return errors.Join(err, rollbackErr)
+		}
+		return err
+	}
+	_, err = db.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	return err
+}
+
+// pgxTxOptions converts the TxOptions into pgx.TxOptions.
+func pgxTxOptions(opts *TxOptions) pgx.TxOptions {
+	var txOptions pgx.TxOptions
+	switch opts.Isolation {
+	case sql.LevelReadUncommitted:
+		txOptions.IsoLevel = pgx.ReadUncommitted
+	case sql.LevelReadCommitted:
+		txOptions.IsoLevel = pgx.ReadCommitted
+	case sql.LevelRepeatableRead, sql.LevelSnapshot:
+		txOptions.IsoLevel = pgx.RepeatableRead
+	case sql.LevelSerializable, sql.LevelLinearizable:
+		txOptions.IsoLevel = pgx.Serializable
+	}
+	if opts.ReadOnly {
+		txOptions.AccessMode = pgx.ReadOnly
+	}
+	return txOptions
+}
+
+// isRetryableTxError reports whether the error is a serialization failure
+// (40001) or a deadlock (40P01), in which case the transaction can be retried.
+func isRetryableTxError(err error) bool {
+	var code string
+	var pqErr *pq.Error
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.As(err, &pqErr):
+		code = string(pqErr.Code)
+	case errors.As(err, &pgErr):
+		code = pgErr.Code
+	}
+	return code == "40001" || code == "40P01"
+}
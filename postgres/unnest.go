@@ -0,0 +1,134 @@
+package sq
+
+import "strings"
+
+// UnnestTable is a Table created by Unnest. Every array that is unnested
+// becomes a column, which is named by calling one of the NumberColumn,
+// StringColumn, BooleanColumn, TimeColumn, UUIDColumn or JSONColumn methods
+// once per array in order.
+type UnnestTable struct {
+	alias      string
+	arrays     []ArrayField
+	columns    []string
+	ordinality string
+}
+
+// Unnest creates a new table that expands the arrays into a set of rows, one
+// row for every element. If more than one array is passed in, the arrays are
+// unnested in parallel and the shorter arrays are padded with NULLs. Postgres
+// only allows a column list if the table has an alias, so the alias must not be
+// empty.
+//
+//	tags := Unnest("tags", p.TAGS)
+//	tag := tags.StringColumn("tag")
+//	From(p).CustomJoin("CROSS JOIN", tags).Select(p.POST_ID, tag)
+func Unnest(alias string, arrays ...ArrayField) *UnnestTable {
+	return &UnnestTable{
+		alias:  alias,
+		arrays: arrays,
+	}
+}
+
+// AppendSQL marshals the UnnestTable into a buffer and an args slice. The
+// column list is written after the table alias by the FROM or JOIN clause.
+func (tbl *UnnestTable) AppendSQL(buf *strings.Builder, args *[]interface{}, params map[string]int) {
+	buf.WriteString("unnest(")
+	for i, array := range tbl.arrays {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		array.AppendSQLExclude(buf, args, nil, nil)
+	}
+	buf.WriteString(")")
+	if tbl.ordinality != "" {
+		buf.WriteString(" WITH ORDINALITY")
+	}
+}
+
+// appendColumnAliases writes the column list of the UnnestTable i.e. '(col1,
+// col2, ordinality)'.
+func (tbl *UnnestTable) appendColumnAliases(buf *strings.Builder) {
+	columns := tbl.columns
+	if tbl.ordinality != "" {
+		columns = append(columns[:len(columns):len(columns)], tbl.ordinality)
+	}
+	if len(columns) == 0 {
+		return
+	}
+	buf.WriteString("(")
+	for i, column := range columns {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		if strings.ContainsAny(column, " \t") {
+			buf.WriteString(`"`)
+			buf.WriteString(column)
+			buf.WriteString(`"`)
+		} else {
+			buf.WriteString(column)
+		}
+	}
+	buf.WriteString(")")
+}
+
+// GetAlias implements the Table interface. It returns the alias of the
+// UnnestTable.
+func (tbl *UnnestTable) GetAlias() string {
+	return tbl.alias
+}
+
+// GetName implements the Table interface. It always returns an empty string,
+// as an UnnestTable can only be referred to by its alias.
+func (tbl *UnnestTable) GetName() string {
+	return ""
+}
+
+// WithOrdinality adds a column numbering the rows starting from 1 i.e. 'WITH
+// ORDINALITY', and returns it as a NumberField. The ordinality column always
+// comes after the array columns.
+func (tbl *UnnestTable) WithOrdinality(name string) NumberField {
+	tbl.ordinality = name
+	return NewNumberField(name, tbl)
+}
+
+// NumberColumn names the column of the next array and returns it as a
+// NumberField.
+func (tbl *UnnestTable) NumberColumn(name string) NumberField {
+	tbl.columns = append(tbl.columns, name)
+	return NewNumberField(name, tbl)
+}
+
+// StringColumn names the column of the next array and returns it as a
+// StringField.
+func (tbl *UnnestTable) StringColumn(name string) StringField {
+	tbl.columns = append(tbl.columns, name)
+	return NewStringField(name, tbl)
+}
+
+// BooleanColumn names the column of the next array and returns it as a
+// BooleanField.
+func (tbl *UnnestTable) BooleanColumn(name string) BooleanField {
+	tbl.columns = append(tbl.columns, name)
+	return NewBooleanField(name, tbl)
+}
+
+// TimeColumn names the column of the next array and returns it as a
+// TimeField.
+func (tbl *UnnestTable) TimeColumn(name string) TimeField {
+	tbl.columns = append(tbl.columns, name)
+	return NewTimeField(name, tbl)
+}
+
+// UUIDColumn names the column of the next array and returns it as a
+// UUIDField.
+func (tbl *UnnestTable) UUIDColumn(name string) UUIDField {
+	tbl.columns = append(tbl.columns, name)
+	return NewUUIDField(name, tbl)
+}
+
+// JSONColumn names the column of the next array and returns it as a
+// JSONField.
+func (tbl *UnnestTable) JSONColumn(name string) JSONField {
+	tbl.columns = append(tbl.columns, name)
+	return NewJSONField(name, tbl)
+}
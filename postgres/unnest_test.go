@@ -0,0 +1,61 @@
+package sq
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestUnnest(t *testing.T) {
+	is := is.New(t)
+	u := USERS().As("u")
+	roles := NewArrayField("roles", u)
+
+	// Array column, WITH ORDINALITY
+	tbl := Unnest("r", roles)
+	ord := tbl.WithOrdinality("ord")
+	role := tbl.StringColumn("role")
+	gotQuery, gotArgs := From(u).
+		CustomJoin("CROSS JOIN", tbl).
+		Where(role.NeString("admin")).
+		OrderBy(u.USER_ID, ord).
+		Select(u.USER_ID, role, ord).
+		ToSQL()
+	is.Equal("SELECT u.user_id, r.role, r.ord FROM public.users AS u"+
+		" CROSS JOIN unnest(u.roles) WITH ORDINALITY AS r(role, ord)"+
+		" WHERE r.role <> $1 ORDER BY u.user_id, r.ord", gotQuery)
+	is.Equal([]interface{}{"admin"}, gotArgs)
+
+	// Multiple literal arrays
+	tbl = Unnest("t", Array([]int{1, 2, 3}), Array([]string{"a", "b"}))
+	n := tbl.NumberColumn("n")
+	s := tbl.StringColumn("s")
+	gotQuery, gotArgs = From(tbl).Select(n, s).ToSQL()
+	is.Equal("SELECT t.n, t.s FROM unnest(ARRAY[$1, $2, $3], ARRAY[$4, $5]) AS t(n, s)", gotQuery)
+	is.Equal([]interface{}{1, 2, 3, "a", "b"}, gotArgs)
+
+	if testing.Short() {
+		return
+	}
+	db, err := sql.Open("txdb", "Unnest")
+	is.NoErr(err)
+	defer db.Close()
+	var ns []int
+	var ss []string
+	var num int
+	var str string
+	err = From(tbl).
+		OrderBy(n.Desc()).
+		Selectx(func(row *Row) {
+			num = row.Int(n)
+			str = row.String(s)
+		}, func() {
+			ns = append(ns, num)
+			ss = append(ss, str)
+		}).
+		Fetch(db)
+	is.NoErr(err)
+	is.Equal([]int{3, 2, 1}, ns)
+	is.Equal([]string{"", "b", "a"}, ss)
+}
@@ -36,6 +36,7 @@ type UpdateQuery struct {
 	// Logging
 	Log     Logger
 	LogFlag LogFlag
+	LogFunc LogFunc
 	logSkip int
 }
 
@@ -48,6 +49,15 @@ func (q UpdateQuery) ToSQL() (query string, args []interface{}) {
 	q.logSkip += 1
 	buf := &strings.Builder{}
 	q.AppendSQL(buf, &args, nil)
+	if q.LogFunc != nil {
+		q.LogFunc(LogInfo{
+			LogFlag: q.LogFlag,
+			LogSkip: q.logSkip + 2,
+			Query:   buf.String(),
+			Args:    args,
+			Action:  LogActionToSQL,
+		})
+	}
 	return buf.String(), args
 }
 
@@ -98,11 +108,7 @@ func (q UpdateQuery) AppendSQL(buf *strings.Builder, args *[]interface{}, params
 		default:
 			q.FromTable.AppendSQL(buf, args, nil)
 		}
-		alias := q.FromTable.GetAlias()
-		if alias != "" {
-			buf.WriteString(" AS ")
-			buf.WriteString(alias)
-		}
+		appendTableAlias(buf, q.FromTable)
 	}
 	// JOIN
 	if len(q.JoinTables) > 0 {
@@ -182,6 +188,15 @@ func (q UpdateQuery) From(table Table) UpdateQuery {
 	return q
 }
 
+// Fromx sets the FROM table of the UpdateQuery to the JoinedTables' FROM
+// table, followed by its JoinTables.
+func (q UpdateQuery) Fromx(joined JoinedTablesGetter) UpdateQuery {
+	jt := joined.GetJoinedTables()
+	q.FromTable = jt.FromTable
+	q.JoinTables = jt.prependJoins(q.JoinTables)
+	return q
+}
+
 // Join joins a new table to the UpdateQuery based on the predicates.
 func (q UpdateQuery) Join(table Table, predicate Predicate, predicates ...Predicate) UpdateQuery {
 	predicates = append([]Predicate{predicate}, predicates...)
@@ -289,6 +304,25 @@ func (q UpdateQuery) Fetch(db DB) (err error) {
 // maps the results based on the mapper function (and optionally runs the
 // accumulator function).
 func (q UpdateQuery) FetchContext(ctx context.Context, db DB) (err error) {
+	logBuf := &strings.Builder{}
+	start := time.Now()
+	var rowcount int
+	var logQuery string
+	var logArgs []interface{}
+	if q.LogFunc != nil {
+		defer func() {
+			q.LogFunc(LogInfo{
+				LogFlag:     q.LogFlag,
+				LogSkip:     q.logSkip + 3,
+				Query:       logQuery,
+				Args:        logArgs,
+				Action:      LogActionFetch,
+				TimeTaken:   time.Since(start),
+				Err:         err,
+				RowsFetched: int64(rowcount),
+			})
+		}()
+	}
 	if db == nil {
 		if q.DB == nil {
 			return errors.New("DB cannot be nil")
@@ -298,9 +332,6 @@ func (q UpdateQuery) FetchContext(ctx context.Context, db DB) (err error) {
 	if q.RowMapper == nil {
 		return fmt.Errorf("cannot call Fetch/FetchContext without a mapper")
 	}
-	logBuf := &strings.Builder{}
-	start := time.Now()
-	var rowcount int
 	defer func() {
 		if r := recover(); r != nil {
 			switch v := r.(type) {
@@ -338,18 +369,18 @@ func (q UpdateQuery) FetchContext(ctx context.Context, db DB) (err error) {
 			}
 		}
 	}()
-	r := &Row{}
+	r := newRow(db)
 	q.RowMapper(r)
 	q.ReturningFields = r.fields
 	tmpbuf := &strings.Builder{}
 	var tmpargs []interface{}
 	q.logSkip += 1
 	q.AppendSQL(tmpbuf, &tmpargs, nil)
-	if ctx == nil {
-		r.rows, err = db.Query(tmpbuf.String(), tmpargs...)
-	} else {
-		r.rows, err = db.QueryContext(ctx, tmpbuf.String(), tmpargs...)
+	if q.LogFunc != nil {
+		logQuery = tmpbuf.String()
+		logArgs = append(logArgs, tmpargs...)
 	}
+	r.rows, err = queryRows(ctx, db, tmpbuf.String(), tmpargs)
 	if err != nil {
 		return err
 	}
@@ -413,14 +444,31 @@ func (q UpdateQuery) Exec(db DB, flag ExecFlag) (rowsAffected int64, err error)
 // ExecContext will execute the UpdateQuery with the given DB and context. It will
 // only compute the rowsAffected if the ErowsAffected Execflag is passed to it.
 func (q UpdateQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (rowsAffected int64, err error) {
+	logBuf := &strings.Builder{}
+	start := time.Now()
+	var logQuery string
+	var logArgs []interface{}
+	if q.LogFunc != nil {
+		defer func() {
+			q.LogFunc(LogInfo{
+				LogFlag:      q.LogFlag,
+				LogSkip:      q.logSkip + 3,
+				Query:        logQuery,
+				Args:         logArgs,
+				Action:       LogActionExec,
+				TimeTaken:    time.Since(start),
+				Err:          err,
+				ExecFlag:     flag,
+				RowsAffected: rowsAffected,
+			})
+		}()
+	}
 	if db == nil {
 		if q.DB == nil {
 			return rowsAffected, errors.New("DB cannot be nil")
 		}
 		db = q.DB
 	}
-	logBuf := &strings.Builder{}
-	start := time.Now()
 	defer func() {
 		if r := recover(); r != nil {
 			switch v := r.(type) {
@@ -456,6 +504,9 @@ func (q UpdateQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (row
 	var tmpargs []interface{}
 	q.logSkip += 1
 	q.AppendSQL(tmpbuf, &tmpargs, nil)
+	if q.LogFunc != nil {
+		logQuery, logArgs = tmpbuf.String(), tmpargs
+	}
 	if ctx == nil {
 		res, err = db.Exec(tmpbuf.String(), tmpargs...)
 	} else {
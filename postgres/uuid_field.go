@@ -180,6 +180,24 @@ func (f UUIDField) In(v interface{}) Predicate {
 	}
 }
 
+// EqAny returns an 'X = ANY(Y)' Predicate, which is true if the UUIDField is
+// equal to any element of the ArrayField.
+func (f UUIDField) EqAny(array ArrayField) Predicate {
+	return CustomPredicate{
+		Format: "? = ANY(?)",
+		Values: []interface{}{f, array},
+	}
+}
+
+// NeAll returns an 'X <> ALL(Y)' Predicate, which is true if the UUIDField is
+// not equal to every element of the ArrayField.
+func (f UUIDField) NeAll(array ArrayField) Predicate {
+	return CustomPredicate{
+		Format: "? <> ALL(?)",
+		Values: []interface{}{f, array},
+	}
+}
+
 // Asc returns a new UUIDField indicating that it should be ordered in
 // ascending order i.e. 'ORDER BY field ASC'
 func (f UUIDField) Asc() UUIDField {
@@ -196,6 +214,13 @@ func (f UUIDField) Desc() UUIDField {
 	return f
 }
 
+// ordering implements the orderedField interface.
+func (f UUIDField) ordering() (field Field, descending, nullsfirst *bool) {
+	descending = f.descending
+	f.descending = nil
+	return f, descending, nil
+}
+
 // String returns the string representation of the UUIDField
 func (f UUIDField) String() string {
 	buf := &strings.Builder{}
@@ -1,9 +1,15 @@
 package sq
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log"
+	"reflect"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // VariadicQueryOperator is an operator that can join a variadic number of
@@ -34,6 +40,7 @@ type VariadicQuery struct {
 	// Logging
 	Log     Logger
 	LogFlag LogFlag
+	LogFunc LogFunc
 	logSkip int
 }
 
@@ -43,6 +50,15 @@ func (vq VariadicQuery) ToSQL() (string, []interface{}) {
 	buf := &strings.Builder{}
 	var args []interface{}
 	vq.AppendSQL(buf, &args, nil)
+	if vq.LogFunc != nil {
+		vq.LogFunc(LogInfo{
+			LogFlag: vq.LogFlag,
+			LogSkip: vq.logSkip + 2,
+			Query:   buf.String(),
+			Args:    args,
+			Action:  LogActionToSQL,
+		})
+	}
 	return buf.String(), args
 }
 
@@ -172,3 +188,146 @@ func ExceptAll(queries ...Query) VariadicQuery {
 		Queries:  queries,
 	}
 }
+
+// Fetch will run VariadicQuery with the given DB. It then maps the results
+// based on the mapper function (and optionally runs the accumulator function).
+// Since every query in a VariadicQuery has its own SELECT clause, the fields
+// in the mapper are only used to determine the type of each column and must
+// be listed in the same order as the columns in the first query.
+func (vq VariadicQuery) Fetch(db DB) (err error) {
+	vq.logSkip += 1
+	return vq.FetchContext(nil, db)
+}
+
+// FetchContext will run VariadicQuery with the given DB and context. It then
+// maps the results based on the mapper function (and optionally runs the
+// accumulator function).
+func (vq VariadicQuery) FetchContext(ctx context.Context, db DB) (err error) {
+	logBuf := &strings.Builder{}
+	start := time.Now()
+	var rowcount int
+	var logQuery string
+	var logArgs []interface{}
+	if vq.LogFunc != nil {
+		defer func() {
+			vq.LogFunc(LogInfo{
+				LogFlag:     vq.LogFlag,
+				LogSkip:     vq.logSkip + 3,
+				Query:       logQuery,
+				Args:        logArgs,
+				Action:      LogActionFetch,
+				TimeTaken:   time.Since(start),
+				Err:         err,
+				RowsFetched: int64(rowcount),
+			})
+		}()
+	}
+	if db == nil {
+		if vq.DB == nil {
+			return errors.New("DB cannot be nil")
+		}
+		db = vq.DB
+	}
+	if vq.Mapper == nil {
+		return fmt.Errorf("cannot call Fetch/FetchContext without a mapper")
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			switch v := r.(type) {
+			case ExitCode:
+				if v != ExitPeacefully {
+					err = v
+				}
+			case error:
+				err = v
+			default:
+				err = fmt.Errorf("%#v", r)
+			}
+			return
+		}
+		if vq.Log == nil {
+			return
+		}
+		elapsed := time.Since(start)
+		if Lresults&vq.LogFlag != 0 && rowcount > 5 {
+			logBuf.WriteString("\n...")
+		}
+		if Lstats&vq.LogFlag != 0 {
+			logBuf.WriteString("\n(Fetched ")
+			logBuf.WriteString(strconv.Itoa(rowcount))
+			logBuf.WriteString(" rows in ")
+			logBuf.WriteString(elapsed.String())
+			logBuf.WriteString(")")
+		}
+		if logBuf.Len() > 0 {
+			switch vq.Log.(type) {
+			case *log.Logger:
+				_ = vq.Log.Output(vq.logSkip+2, logBuf.String())
+			default:
+				_ = vq.Log.Output(vq.logSkip+1, logBuf.String())
+			}
+		}
+	}()
+	r := newRow(db)
+	vq.Mapper(r)
+	tmpbuf := &strings.Builder{}
+	var tmpargs []interface{}
+	vq.logSkip += 1
+	vq.AppendSQL(tmpbuf, &tmpargs, nil)
+	if vq.LogFunc != nil {
+		logQuery = tmpbuf.String()
+		logArgs = append(logArgs, tmpargs...)
+	}
+	r.rows, err = queryRows(ctx, db, tmpbuf.String(), tmpargs)
+	if err != nil {
+		return err
+	}
+	defer r.rows.Close()
+	if len(r.dest) == 0 {
+		return nil
+	}
+	for r.rows.Next() {
+		rowcount++
+		err = r.rows.Scan(r.dest...)
+		if err != nil {
+			errbuf := &strings.Builder{}
+			for i := range r.dest {
+				tmpbuf.Reset()
+				tmpargs = tmpargs[:0]
+				r.fields[i].AppendSQLExclude(tmpbuf, &tmpargs, nil, nil)
+				errbuf.WriteString("\n" +
+					strconv.Itoa(i) + ") " +
+					dollarInterpolate(tmpbuf.String(), tmpargs...) + " => " +
+					reflect.TypeOf(r.dest[i]).String())
+			}
+			return fmt.Errorf("Please check if your mapper function is correct:%s\n%w", errbuf.String(), err)
+		}
+		if vq.Log != nil && Lresults&vq.LogFlag != 0 && rowcount <= 5 {
+			logBuf.WriteString("\n----[ Row ")
+			logBuf.WriteString(strconv.Itoa(rowcount))
+			logBuf.WriteString(" ]----")
+			for i := range r.dest {
+				tmpbuf.Reset()
+				tmpargs = tmpargs[:0]
+				r.fields[i].AppendSQLExclude(tmpbuf, &tmpargs, nil, nil)
+				logBuf.WriteString("\n")
+				logBuf.WriteString(dollarInterpolate(tmpbuf.String(), tmpargs...))
+				logBuf.WriteString(": ")
+				logBuf.WriteString(appendSQLDisplay(r.dest[i]))
+			}
+		}
+		r.index = 0
+		vq.Mapper(r)
+		if vq.Accumulator == nil {
+			break
+		}
+		vq.Accumulator()
+	}
+	if rowcount == 0 && vq.Accumulator == nil {
+		return sql.ErrNoRows
+	}
+	if e := r.rows.Close(); e != nil {
+		return e
+	}
+	return r.rows.Err()
+}
@@ -2,25 +2,37 @@ package postgres
 
 // sq Field Types
 const (
-	FieldTypeBoolean = "sq.BooleanField"
-	FieldTypeJSON    = "sq.JSONField"
-	FieldTypeNumber  = "sq.NumberField"
-	FieldTypeString  = "sq.StringField"
-	FieldTypeTime    = "sq.TimeField"
-	FieldTypeEnum    = "sq.EnumField"
-	FieldTypeArray   = "sq.ArrayField"
-	FieldTypeBinary  = "sq.BinaryField"
-	FieldTypeUUID    = "sq.UUIDField"
+	FieldTypeBoolean  = "sq.BooleanField"
+	FieldTypeJSON     = "sq.JSONField"
+	FieldTypeNumber   = "sq.NumberField"
+	FieldTypeString   = "sq.StringField"
+	FieldTypeTime     = "sq.TimeField"
+	FieldTypeEnum     = "sq.EnumField"
+	FieldTypeArray    = "sq.ArrayField"
+	FieldTypeBinary   = "sq.BinaryField"
+	FieldTypeUUID     = "sq.UUIDField"
+	FieldTypeTSVector = "sq.TSVectorField"
+	FieldTypeRange    = "sq.RangeField"
+	FieldTypeInterval = "sq.IntervalField"
 
-	FieldConstructorBoolean = "sq.NewBooleanField"
-	FieldConstructorJSON    = "sq.NewJSONField"
-	FieldConstructorNumber  = "sq.NewNumberField"
-	FieldConstructorString  = "sq.NewStringField"
-	FieldConstructorTime    = "sq.NewTimeField"
-	FieldConstructorEnum    = "sq.NewEnumField"
-	FieldConstructorArray   = "sq.NewArrayField"
-	FieldConstructorBinary  = "sq.NewBinaryField"
-	FieldConstructorUUID    = "sq.NewUUIDField"
+	FieldConstructorBoolean  = "sq.NewBooleanField"
+	FieldConstructorJSON     = "sq.NewJSONField"
+	FieldConstructorNumber   = "sq.NewNumberField"
+	FieldConstructorString   = "sq.NewStringField"
+	FieldConstructorTime     = "sq.NewTimeField"
+	FieldConstructorEnum     = "sq.NewEnumField"
+	FieldConstructorArray    = "sq.NewArrayField"
+	FieldConstructorBinary   = "sq.NewBinaryField"
+	FieldConstructorUUID     = "sq.NewUUIDField"
+	FieldConstructorTSVector = "sq.NewTSVectorField"
+	FieldConstructorInterval = "sq.NewIntervalField"
+
+	FieldConstructorTSTZRange = "sq.NewTSTZRangeField"
+	FieldConstructorTSRange   = "sq.NewTSRangeField"
+	FieldConstructorDateRange = "sq.NewDateRangeField"
+	FieldConstructorInt4Range = "sq.NewInt4RangeField"
+	FieldConstructorInt8Range = "sq.NewInt8RangeField"
+	FieldConstructorNumRange  = "sq.NewNumRangeField"
 )
 
 // Go Types
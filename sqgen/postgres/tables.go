@@ -295,5 +295,34 @@ func (field TableField) Populate() TableField {
 		return field
 	}
 
+	if field.RawType == "interval" {
+		field.Type = FieldTypeInterval
+		field.Constructor = FieldConstructorInterval
+		return field
+	}
+
+	// Full text search
+	if field.RawType == "tsvector" {
+		field.Type = FieldTypeTSVector
+		field.Constructor = FieldConstructorTSVector
+		return field
+	}
+
+	// Range
+	switch field.RawType {
+	case "tstzrange":
+		field.Type, field.Constructor = FieldTypeRange, FieldConstructorTSTZRange
+	case "tsrange":
+		field.Type, field.Constructor = FieldTypeRange, FieldConstructorTSRange
+	case "daterange":
+		field.Type, field.Constructor = FieldTypeRange, FieldConstructorDateRange
+	case "int4range":
+		field.Type, field.Constructor = FieldTypeRange, FieldConstructorInt4Range
+	case "int8range":
+		field.Type, field.Constructor = FieldTypeRange, FieldConstructorInt8Range
+	case "numrange":
+		field.Type, field.Constructor = FieldTypeRange, FieldConstructorNumRange
+	}
+
 	return field
 }
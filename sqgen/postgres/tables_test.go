@@ -387,6 +387,58 @@ func TestTableFieldPopulate(t *testing.T) {
 				Constructor: FieldConstructorBinary,
 			},
 		},
+		{
+			name: "interval field",
+			field: TableField{
+				Name:    "duration",
+				RawType: "interval",
+			},
+			result: TableField{
+				Name:        "duration",
+				RawType:     "interval",
+				Type:        FieldTypeInterval,
+				Constructor: FieldConstructorInterval,
+			},
+		},
+		{
+			name: "tsvector field",
+			field: TableField{
+				Name:    "search_text",
+				RawType: "tsvector",
+			},
+			result: TableField{
+				Name:        "search_text",
+				RawType:     "tsvector",
+				Type:        FieldTypeTSVector,
+				Constructor: FieldConstructorTSVector,
+			},
+		},
+		{
+			name: "tstzrange field",
+			field: TableField{
+				Name:    "period",
+				RawType: "tstzrange",
+			},
+			result: TableField{
+				Name:        "period",
+				RawType:     "tstzrange",
+				Type:        FieldTypeRange,
+				Constructor: FieldConstructorTSTZRange,
+			},
+		},
+		{
+			name: "int4range field",
+			field: TableField{
+				Name:    "seats",
+				RawType: "int4range",
+			},
+			result: TableField{
+				Name:        "seats",
+				RawType:     "int4range",
+				Type:        FieldTypeRange,
+				Constructor: FieldConstructorInt4Range,
+			},
+		},
 	}
 
 	numberFields := []string{
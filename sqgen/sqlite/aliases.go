@@ -0,0 +1,18 @@
+package sqlite
+
+// Field Types
+const (
+	FieldTypeBoolean = "sq.BooleanField"
+	FieldTypeJSON    = "sq.JSONField"
+	FieldTypeNumber  = "sq.NumberField"
+	FieldTypeString  = "sq.StringField"
+	FieldTypeTime    = "sq.TimeField"
+	FieldTypeBinary  = "sq.BinaryField"
+
+	FieldConstructorBoolean = "sq.NewBooleanField"
+	FieldConstructorJSON    = "sq.NewJSONField"
+	FieldConstructorNumber  = "sq.NewNumberField"
+	FieldConstructorString  = "sq.NewStringField"
+	FieldConstructorTime    = "sq.NewTimeField"
+	FieldConstructorBinary  = "sq.NewBinaryField"
+)
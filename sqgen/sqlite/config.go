@@ -0,0 +1,22 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/bokwoon95/go-structured-query/sqgen"
+)
+
+type Config struct {
+	// (required) DB URL
+	DB *sql.DB
+	// Package name of the file to be generated
+	Package string
+	// Slice of database schemas that you want to generate tables for. In
+	// SQLite these are "main", "temp" or the name of an attached database.
+	// Defaults to "main" if empty
+	Schemas []string
+	// Slice of case-insensitive table names or functions to exclude from generation
+	Exclude []string
+	// Used to log any skipped/unsupported column types
+	Logger sqgen.Logger
+}
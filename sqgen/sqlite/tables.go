@@ -0,0 +1,297 @@
+package sqlite
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/bokwoon95/go-structured-query/sqgen"
+)
+
+type Table struct {
+	Schema      string
+	Name        string
+	StructName  string
+	RawType     string
+	Constructor string
+	Fields      []TableField
+}
+
+// TableField represents a field in a database table
+//
+// SQLite columns can be declared with any type name at all (or none), which is
+// mapped into TableField.RawType. The field type is picked from the declared
+// type using the same substring rules that SQLite uses to determine a column's
+// type affinity, see https://www.sqlite.org/datatype3.html#determination_of_column_affinity
+type TableField struct {
+	Name        string
+	RawType     string
+	Type        string
+	Constructor string
+}
+
+func BuildTables(config Config, writer io.Writer) (int, error) {
+	tables, err := executeTables(config)
+
+	if err != nil {
+		return 0, sqgen.Wrap(err)
+	}
+
+	templateData := TablesTemplateData{
+		PackageName: config.Package,
+		Imports: []string{
+			`sq "github.com/bokwoon95/go-structured-query/sqlite"`,
+		},
+		Tables: tables,
+	}
+
+	t, err := getTablesTemplate()
+
+	if err != nil {
+		return 0, sqgen.Wrap(err)
+	}
+
+	var buf bytes.Buffer
+	err = t.Execute(&buf, templateData)
+
+	if err != nil {
+		return 0, sqgen.Wrap(err)
+	}
+
+	src, err := sqgen.FormatOutput(buf.Bytes())
+
+	if err != nil {
+		return 0, sqgen.Wrap(err)
+	}
+
+	_, err = writer.Write(src)
+
+	return len(tables), err
+}
+
+func executeTables(config Config) ([]Table, error) {
+	schemas := config.Schemas
+	if len(schemas) == 0 {
+		schemas = []string{"main"}
+	}
+
+	query, args := buildTablesQuery(schemas, config.Exclude)
+
+	rows, err := config.DB.Query(query, args...)
+
+	if err != nil {
+		return nil, sqgen.Wrap(err)
+	}
+
+	defer rows.Close()
+
+	// map of full table name (including schema) to table pointer
+	tableMap := make(map[string]*Table)
+
+	// keeps track of how many time a table name appears (irrespective of schema)
+	// used later to deduplicate table definitions with schema name
+	tableNameCount := make(map[string]int)
+
+	//keeps track of the order of tables as they appear in the sorted query (by schema name + table name)
+	// required, as tableMap is inherently unordered
+	var orderedTables []string
+
+	for rows.Next() {
+		var tableType, tableSchema, tableName, columnName, columnType string
+
+		if err := rows.Scan(&tableType, &tableSchema, &tableName, &columnName, &columnType); err != nil {
+			return nil, err
+		}
+
+		// used to index the tableMap
+		fullTableName := tableSchema + "." + tableName
+
+		// add table to map if not already exists
+
+		if _, ok := tableMap[fullTableName]; !ok {
+			table := &Table{
+				Schema:  tableSchema,
+				Name:    tableName,
+				RawType: tableType,
+			}
+			tableNameCount[tableName]++
+			tableMap[fullTableName] = table
+			orderedTables = append(orderedTables, fullTableName)
+		}
+
+		field := TableField{
+			Name:    columnName,
+			RawType: columnType,
+		}
+
+		tableMap[fullTableName].Fields = append(tableMap[fullTableName].Fields, field)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var tables []Table
+
+	for _, fullTableName := range orderedTables {
+		table := tableMap[fullTableName]
+		isDuplicate := tableNameCount[table.Name] > 1
+		t := table.Populate(&config, isDuplicate)
+
+		tables = append(tables, t)
+	}
+
+	return tables, nil
+}
+
+// buildTablesQuery lists the columns of every table and view in the schemas.
+// Each schema has its own sqlite_master table, so the schema names cannot be
+// passed in as arguments and are quoted into the query instead.
+func buildTablesQuery(schemas, exclude []string) (string, []interface{}) {
+	var args []interface{}
+	selects := make([]string, len(schemas))
+
+	for i, schema := range schemas {
+		query := "SELECT m.type AS table_type, ? AS table_schema, m.name AS table_name, p.name AS column_name, p.type AS column_type" +
+			" FROM " + quoteIdentifier(schema) + ".sqlite_master AS m" +
+			" JOIN pragma_table_info(m.name, ?) AS p" +
+			" WHERE m.type IN ('table', 'view') AND m.name NOT LIKE 'sqlite_%'"
+
+		args = append(args, schema, schema)
+
+		if len(exclude) > 0 {
+			query += " AND m.name COLLATE NOCASE NOT IN " + sqgen.SliceToSQL(exclude)
+
+			for _, ex := range exclude {
+				args = append(args, ex)
+			}
+		}
+
+		selects[i] = query
+	}
+
+	query := strings.Join(selects, " UNION ALL ") +
+		" ORDER BY table_schema, table_type, table_name, column_name"
+
+	return query, args
+}
+
+// quoteIdentifier quotes a schema name so that it can be used as an
+// identifier in a query.
+func quoteIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+func (table Table) Populate(config *Config, isDuplicate bool) Table {
+	table.StructName = "TABLE_"
+
+	if table.RawType == "view" {
+		table.StructName = "VIEW_"
+	}
+
+	if isDuplicate {
+		table.StructName += strings.ToUpper(table.Schema) + "__"
+		table.Constructor += strings.ToUpper(table.Schema) + "__"
+	}
+
+	table.StructName += strings.ToUpper(table.Name)
+	table.Constructor += strings.ToUpper(table.Name)
+
+	var fields []TableField
+
+	for _, field := range table.Fields {
+		f := field.Populate()
+
+		if f.Type == "" {
+			if config != nil {
+				config.Logger.Printf(
+					"Skipping %s.%s because type '%s' is unknown\n",
+					table.Name,
+					field.Name,
+					field.RawType,
+				)
+			}
+			continue
+		}
+
+		if strings.ToLower(f.Name) != f.Name {
+			if config != nil {
+				config.Logger.Printf(
+					"Skipping %s.%s because column name is case sensitive\n",
+					table.Name,
+					field.Name,
+				)
+			}
+			continue
+		}
+
+		fields = append(fields, f)
+	}
+
+	table.Fields = fields
+
+	return table
+}
+
+func (field TableField) Populate() TableField {
+	rawType := strings.ToUpper(field.RawType)
+
+	// Boolean
+	if strings.Contains(rawType, "BOOL") {
+		field.Type = FieldTypeBoolean
+		field.Constructor = FieldConstructorBoolean
+		return field
+	}
+
+	// JSON
+	if strings.Contains(rawType, "JSON") {
+		field.Type = FieldTypeJSON
+		field.Constructor = FieldConstructorJSON
+		return field
+	}
+
+	// Number (INTEGER affinity)
+	if strings.Contains(rawType, "INT") {
+		field.Type = FieldTypeNumber
+		field.Constructor = FieldConstructorNumber
+		return field
+	}
+
+	// String (TEXT affinity)
+	if strings.Contains(rawType, "CHAR") || strings.Contains(rawType, "CLOB") || strings.Contains(rawType, "TEXT") {
+		field.Type = FieldTypeString
+		field.Constructor = FieldConstructorString
+		return field
+	}
+
+	// Blob (BLOB affinity). Columns without a declared type are skipped
+	// because they may hold anything.
+	if strings.Contains(rawType, "BLOB") {
+		field.Type = FieldTypeBinary
+		field.Constructor = FieldConstructorBinary
+		return field
+	}
+
+	// Number (REAL affinity)
+	if strings.Contains(rawType, "REAL") || strings.Contains(rawType, "FLOA") || strings.Contains(rawType, "DOUB") {
+		field.Type = FieldTypeNumber
+		field.Constructor = FieldConstructorNumber
+		return field
+	}
+
+	// Time (NUMERIC affinity, but conventionally used for dates and times)
+	if strings.Contains(rawType, "DATE") || strings.Contains(rawType, "TIME") {
+		field.Type = FieldTypeTime
+		field.Constructor = FieldConstructorTime
+		return field
+	}
+
+	// Number (NUMERIC affinity)
+	if strings.Contains(rawType, "NUMERIC") || strings.Contains(rawType, "DECIMAL") {
+		field.Type = FieldTypeNumber
+		field.Constructor = FieldConstructorNumber
+		return field
+	}
+
+	return field
+}
@@ -0,0 +1,372 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestBuildTablesQuery(t *testing.T) {
+	t.Run("single schema, no excluded tables", func(t *testing.T) {
+		is := is.New(t)
+
+		schemas := []string{"main"}
+		exclude := []string{}
+
+		query, args := buildTablesQuery(schemas, exclude)
+
+		expectedQuery := `SELECT m.type AS table_type, ? AS table_schema, m.name AS table_name, p.name AS column_name, p.type AS column_type FROM "main".sqlite_master AS m JOIN pragma_table_info(m.name, ?) AS p WHERE m.type IN ('table', 'view') AND m.name NOT LIKE 'sqlite_%' ORDER BY table_schema, table_type, table_name, column_name`
+
+		expectedArgs := []interface{}{"main", "main"}
+
+		is.Equal(query, expectedQuery)
+		is.Equal(args, expectedArgs)
+	})
+
+	t.Run("multiple schemas, no excluded tables", func(t *testing.T) {
+		is := is.New(t)
+
+		schemas := []string{"main", "geo"}
+		exclude := []string{}
+
+		query, args := buildTablesQuery(schemas, exclude)
+
+		expectedQuery := `SELECT m.type AS table_type, ? AS table_schema, m.name AS table_name, p.name AS column_name, p.type AS column_type FROM "main".sqlite_master AS m JOIN pragma_table_info(m.name, ?) AS p WHERE m.type IN ('table', 'view') AND m.name NOT LIKE 'sqlite_%'` +
+			` UNION ALL SELECT m.type AS table_type, ? AS table_schema, m.name AS table_name, p.name AS column_name, p.type AS column_type FROM "geo".sqlite_master AS m JOIN pragma_table_info(m.name, ?) AS p WHERE m.type IN ('table', 'view') AND m.name NOT LIKE 'sqlite_%'` +
+			` ORDER BY table_schema, table_type, table_name, column_name`
+
+		expectedArgs := []interface{}{"main", "main", "geo", "geo"}
+
+		is.Equal(query, expectedQuery)
+		is.Equal(args, expectedArgs)
+	})
+
+	t.Run("multiple schemas, excluded tables", func(t *testing.T) {
+		is := is.New(t)
+
+		schemas := []string{"main", "geo"}
+		exclude := []string{"schema_migrations", "meta"}
+
+		query, args := buildTablesQuery(schemas, exclude)
+
+		expectedQuery := `SELECT m.type AS table_type, ? AS table_schema, m.name AS table_name, p.name AS column_name, p.type AS column_type FROM "main".sqlite_master AS m JOIN pragma_table_info(m.name, ?) AS p WHERE m.type IN ('table', 'view') AND m.name NOT LIKE 'sqlite_%' AND m.name COLLATE NOCASE NOT IN (?, ?)` +
+			` UNION ALL SELECT m.type AS table_type, ? AS table_schema, m.name AS table_name, p.name AS column_name, p.type AS column_type FROM "geo".sqlite_master AS m JOIN pragma_table_info(m.name, ?) AS p WHERE m.type IN ('table', 'view') AND m.name NOT LIKE 'sqlite_%' AND m.name COLLATE NOCASE NOT IN (?, ?)` +
+			` ORDER BY table_schema, table_type, table_name, column_name`
+
+		expectedArgs := []interface{}{"main", "main", "schema_migrations", "meta", "geo", "geo", "schema_migrations", "meta"}
+
+		is.Equal(query, expectedQuery)
+		is.Equal(args, expectedArgs)
+	})
+
+	t.Run("schema names are quoted", func(t *testing.T) {
+		is := is.New(t)
+
+		query, _ := buildTablesQuery([]string{`my "db"`}, nil)
+
+		expectedQuery := `SELECT m.type AS table_type, ? AS table_schema, m.name AS table_name, p.name AS column_name, p.type AS column_type FROM "my ""db""".sqlite_master AS m JOIN pragma_table_info(m.name, ?) AS p WHERE m.type IN ('table', 'view') AND m.name NOT LIKE 'sqlite_%' ORDER BY table_schema, table_type, table_name, column_name`
+
+		is.Equal(query, expectedQuery)
+	})
+}
+
+func TestTablePopulate(t *testing.T) {
+	type TT struct {
+		name        string
+		table       Table
+		isDuplicate bool
+		result      Table
+	}
+	tests := []TT{
+		{
+			name: "normal table name, not duplicate",
+			table: Table{
+				Name:    "users",
+				Schema:  "main",
+				RawType: "table",
+			},
+			isDuplicate: false,
+			result: Table{
+				Name:        "users",
+				Schema:      "main",
+				RawType:     "table",
+				StructName:  "TABLE_USERS",
+				Constructor: "USERS",
+			},
+		},
+		{
+			name: "normal table name, is duplicate",
+			table: Table{
+				Name:    "users",
+				Schema:  "main",
+				RawType: "table",
+			},
+			isDuplicate: true,
+			result: Table{
+				Name:        "users",
+				Schema:      "main",
+				RawType:     "table",
+				StructName:  "TABLE_MAIN__USERS",
+				Constructor: "MAIN__USERS",
+			},
+		},
+		{
+			name: "normal table name with different schema, is duplicate",
+			table: Table{
+				Name:    "users",
+				Schema:  "geo",
+				RawType: "table",
+			},
+			isDuplicate: true,
+			result: Table{
+				Name:        "users",
+				Schema:      "geo",
+				RawType:     "table",
+				StructName:  "TABLE_GEO__USERS",
+				Constructor: "GEO__USERS",
+			},
+		},
+		{
+			name: "normal view, is not duplicate",
+			table: Table{
+				Name:    "verified_users",
+				Schema:  "main",
+				RawType: "view",
+			},
+			isDuplicate: false,
+			result: Table{
+				Name:        "verified_users",
+				Schema:      "main",
+				RawType:     "view",
+				StructName:  "VIEW_VERIFIED_USERS",
+				Constructor: "VERIFIED_USERS",
+			},
+		},
+		{
+			name: "normal table name, not duplicate, skips unknown fields",
+			table: Table{
+				Name:    "users",
+				Schema:  "main",
+				RawType: "table",
+				Fields: []TableField{
+					{
+						Name:    "id",
+						RawType: "",
+					},
+				},
+			},
+			isDuplicate: false,
+			result: Table{
+				Name:        "users",
+				Schema:      "main",
+				RawType:     "table",
+				StructName:  "TABLE_USERS",
+				Constructor: "USERS",
+			},
+		},
+		{
+			name: "normal table name, not duplicate, skips case-sensitive field names",
+			table: Table{
+				Name:    "users",
+				Schema:  "main",
+				RawType: "table",
+				Fields: []TableField{
+					{
+						Name:    "ID",
+						RawType: "TEXT",
+					},
+				},
+			},
+			isDuplicate: false,
+			result: Table{
+				Name:        "users",
+				Schema:      "main",
+				RawType:     "table",
+				StructName:  "TABLE_USERS",
+				Constructor: "USERS",
+			},
+		},
+		{
+			name: "normal table name, not duplicate, can populate multiple fields",
+			table: Table{
+				Name:    "users",
+				Schema:  "main",
+				RawType: "table",
+				Fields: []TableField{
+					{
+						Name:    "id",
+						RawType: "INTEGER",
+					},
+					{
+						Name:    "first_name",
+						RawType: "TEXT",
+					},
+					{
+						Name:    "last_name",
+						RawType: "VARCHAR(255)",
+					},
+					{
+						Name:    "date_created",
+						RawType: "DATETIME",
+					},
+					{
+						Name:    "is_verified",
+						RawType: "BOOLEAN",
+					},
+					{
+						Name:    "data",
+						RawType: "JSON",
+					},
+				},
+			},
+			isDuplicate: false,
+			result: Table{
+				Name:        "users",
+				Schema:      "main",
+				RawType:     "table",
+				StructName:  "TABLE_USERS",
+				Constructor: "USERS",
+				Fields: []TableField{
+					{
+						Name:        "id",
+						RawType:     "INTEGER",
+						Type:        FieldTypeNumber,
+						Constructor: FieldConstructorNumber,
+					},
+					{
+						Name:        "first_name",
+						RawType:     "TEXT",
+						Type:        FieldTypeString,
+						Constructor: FieldConstructorString,
+					},
+					{
+						Name:        "last_name",
+						RawType:     "VARCHAR(255)",
+						Type:        FieldTypeString,
+						Constructor: FieldConstructorString,
+					},
+					{
+						Name:        "date_created",
+						RawType:     "DATETIME",
+						Type:        FieldTypeTime,
+						Constructor: FieldConstructorTime,
+					},
+					{
+						Name:        "is_verified",
+						RawType:     "BOOLEAN",
+						Type:        FieldTypeBoolean,
+						Constructor: FieldConstructorBoolean,
+					},
+					{
+						Name:        "data",
+						RawType:     "JSON",
+						Type:        FieldTypeJSON,
+						Constructor: FieldConstructorJSON,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+			is.Equal(tt.table.Populate(nil, tt.isDuplicate), tt.result)
+		})
+	}
+}
+
+func TestTableFieldPopulate(t *testing.T) {
+	type TT struct {
+		name   string
+		field  TableField
+		result TableField
+	}
+
+	tests := []TT{
+		{
+			name: "untyped field",
+			field: TableField{
+				Name:    "anything",
+				RawType: "",
+			},
+			result: TableField{
+				Name:    "anything",
+				RawType: "",
+			},
+		},
+		{
+			name: "unknown field",
+			field: TableField{
+				Name:    "id",
+				RawType: "UUID",
+			},
+			result: TableField{
+				Name:    "id",
+				RawType: "UUID",
+			},
+		},
+	}
+
+	fieldTypes := []struct {
+		rawTypes    []string
+		typ         string
+		constructor string
+	}{
+		{
+			rawTypes:    []string{"BOOLEAN", "bool"},
+			typ:         FieldTypeBoolean,
+			constructor: FieldConstructorBoolean,
+		},
+		{
+			rawTypes:    []string{"JSON", "jsonb"},
+			typ:         FieldTypeJSON,
+			constructor: FieldConstructorJSON,
+		},
+		{
+			rawTypes:    []string{"INTEGER", "INT", "BIGINT", "UNSIGNED BIG INT", "tinyint", "REAL", "DOUBLE PRECISION", "FLOAT", "NUMERIC", "DECIMAL(10,5)"},
+			typ:         FieldTypeNumber,
+			constructor: FieldConstructorNumber,
+		},
+		{
+			rawTypes:    []string{"TEXT", "VARCHAR(255)", "CHARACTER(20)", "NVARCHAR(100)", "CLOB"},
+			typ:         FieldTypeString,
+			constructor: FieldConstructorString,
+		},
+		{
+			rawTypes:    []string{"BLOB"},
+			typ:         FieldTypeBinary,
+			constructor: FieldConstructorBinary,
+		},
+		{
+			rawTypes:    []string{"DATE", "DATETIME", "TIMESTAMP", "timestamptz"},
+			typ:         FieldTypeTime,
+			constructor: FieldConstructorTime,
+		},
+	}
+
+	for _, fieldType := range fieldTypes {
+		for _, rawType := range fieldType.rawTypes {
+			tests = append(tests, TT{
+				name: rawType + " field",
+				field: TableField{
+					Name:    "field",
+					RawType: rawType,
+				},
+				result: TableField{
+					Name:        "field",
+					RawType:     rawType,
+					Type:        fieldType.typ,
+					Constructor: fieldType.constructor,
+				},
+			})
+		}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := is.New(t)
+			is.Equal(tt.field.Populate(), tt.result)
+		})
+	}
+}
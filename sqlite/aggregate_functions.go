@@ -0,0 +1,173 @@
+package sq
+
+// Count represents the COUNT(*) aggregate function.
+func Count() NumberField {
+	format := "COUNT(*)"
+	return NumberField{
+		format: &format,
+	}
+}
+
+// CountOver represents the COUNT(*) OVER window function.
+func CountOver(window Window) NumberField {
+	format := "COUNT(*) OVER ?"
+	return NumberField{
+		format: &format,
+		values: []interface{}{window},
+	}
+}
+
+// Sum represents the SUM() aggregate function.
+func Sum(field interface{}) NumberField {
+	format := "SUM(?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{field},
+	}
+}
+
+// SumOver represents the SUM() OVER window function.
+func SumOver(field interface{}, window Window) NumberField {
+	format := "SUM(?) OVER ?"
+	return NumberField{
+		format: &format,
+		values: []interface{}{field, window},
+	}
+}
+
+// Avg represents the AVG() aggregate function.
+func Avg(field interface{}) NumberField {
+	format := "AVG(?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{field},
+	}
+}
+
+// AvgOver represents the AVG() OVER window function.
+func AvgOver(field interface{}, window Window) NumberField {
+	format := "AVG(?) OVER ?"
+	return NumberField{
+		format: &format,
+		values: []interface{}{field, window},
+	}
+}
+
+// Min represents the MIN() aggregate function.
+func Min(field interface{}) NumberField {
+	format := "MIN(?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{field},
+	}
+}
+
+// MinOver represents the MIN() OVER window function.
+func MinOver(field interface{}, window Window) NumberField {
+	format := "MIN(?) OVER ?"
+	return NumberField{
+		format: &format,
+		values: []interface{}{field, window},
+	}
+}
+
+// Max represents the MAX() aggregate function.
+func Max(field interface{}) NumberField {
+	format := "MAX(?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{field},
+	}
+}
+
+// MaxOver represents the MAX() OVER window function.
+func MaxOver(field interface{}, window Window) NumberField {
+	format := "MAX(?) OVER ?"
+	return NumberField{
+		format: &format,
+		values: []interface{}{field, window},
+	}
+}
+
+// CountDistinct represents the COUNT(DISTINCT) aggregate function, which
+// counts the distinct non-NULL values of the field.
+func CountDistinct(field interface{}) NumberField {
+	format := "COUNT(DISTINCT ?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{field},
+	}
+}
+
+// Distinct marks the argument of an aggregate function so that only its
+// distinct values are aggregated e.g. Sum(Distinct(field)) is 'SUM(DISTINCT
+// field)'. SQLite only allows DISTINCT in aggregate functions that take a
+// single argument, so GroupConcat must be called with a separator of ",".
+func Distinct(field interface{}) CustomField {
+	return CustomField{
+		Format: "DISTINCT ?",
+		Values: []interface{}{field},
+	}
+}
+
+// GroupConcat represents the GROUP_CONCAT() aggregate function, which
+// concatenates the values separated by the separator. The values are
+// concatenated in the order of the orderBy fields if any i.e.
+// 'GROUP_CONCAT(field, separator ORDER BY orderBy)'. If the separator is ","
+// it is left out, which is the SQLite default.
+func GroupConcat(field interface{}, separator string, orderBy ...Field) StringField {
+	orderByFormat, orderByValues := aggregateOrderBy(orderBy)
+	values := []interface{}{field}
+	format := "GROUP_CONCAT(?"
+	if separator != "," {
+		format += ", ?"
+		values = append(values, separator)
+	}
+	format += orderByFormat + ")"
+	return StringField{
+		format: &format,
+		values: append(values, orderByValues...),
+	}
+}
+
+// JSONGroupArray represents the json_group_array() aggregate function, which
+// collects the values into a JSON array in the order of the orderBy fields if
+// any i.e. 'json_group_array(field ORDER BY orderBy)'.
+func JSONGroupArray(field interface{}, orderBy ...Field) JSONField {
+	orderByFormat, orderByValues := aggregateOrderBy(orderBy)
+	format := "json_group_array(?" + orderByFormat + ")"
+	return JSONField{
+		format: &format,
+		values: append([]interface{}{field}, orderByValues...),
+	}
+}
+
+// JSONGroupObject represents the json_group_object() aggregate function, which
+// collects the key value pairs into a JSON object.
+func JSONGroupObject(key, value interface{}) JSONField {
+	format := "json_group_object(?, ?)"
+	return JSONField{
+		format: &format,
+		values: []interface{}{key, value},
+	}
+}
+
+// aggregateOrderBy returns the format and values of the ORDER BY clause inside
+// an aggregate function, or nothing if there are no orderBy fields. SQLite
+// supports ORDER BY inside aggregate functions from version 3.44.0 onwards.
+func aggregateOrderBy(orderBy []Field) (format string, values []interface{}) {
+	if len(orderBy) == 0 {
+		return "", nil
+	}
+	return " ORDER BY ?", []interface{}{Fields(orderBy)}
+}
+
+// toplevelPredicate removes the enclosing brackets of a VariadicPredicate that
+// is the only predicate inside a FILTER (WHERE ...) clause.
+func toplevelPredicate(predicate Predicate) Predicate {
+	if p, ok := predicate.(VariadicPredicate); ok {
+		p.toplevel = true
+		return p
+	}
+	return predicate
+}
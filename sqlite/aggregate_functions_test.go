@@ -0,0 +1,202 @@
+package sq
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestAggregateFunctions(t *testing.T) {
+	type TT struct {
+		description string
+		f           Field
+		exclude     []string
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	ur := USER_ROLES().As("ur")
+	tests := []TT{
+		{
+			"Count",
+			Count(),
+			nil,
+			"COUNT(*)",
+			nil,
+		},
+		{
+			"CountOver",
+			CountOver(Window{}),
+			nil,
+			"COUNT(*) OVER ()",
+			nil,
+		},
+		{
+			"Sum",
+			Sum(ur.USER_ID),
+			nil,
+			"SUM(ur.user_id)",
+			nil,
+		},
+		{
+			"SumOver",
+			SumOver(ur.USER_ROLE_ID, PartitionBy(ur.USER_ID)),
+			nil,
+			"SUM(ur.user_role_id) OVER (PARTITION BY ur.user_id)",
+			nil,
+		},
+		{
+			"Avg",
+			Avg(ur.USER_ID),
+			nil,
+			"AVG(ur.user_id)",
+			nil,
+		},
+		{
+			"AvgOver",
+			AvgOver(ur.USER_ROLE_ID, PartitionBy(ur.USER_ID)),
+			nil,
+			"AVG(ur.user_role_id) OVER (PARTITION BY ur.user_id)",
+			nil,
+		},
+		{
+			"Min",
+			Min(ur.USER_ROLE_ID),
+			nil,
+			"MIN(ur.user_role_id)",
+			nil,
+		},
+		{
+			"MinOver",
+			MinOver(ur.USER_ROLE_ID, PartitionBy(ur.USER_ID)),
+			nil,
+			"MIN(ur.user_role_id) OVER (PARTITION BY ur.user_id)",
+			nil,
+		},
+		{
+			"Max",
+			Max(ur.USER_ROLE_ID),
+			nil,
+			"MAX(ur.user_role_id)",
+			nil,
+		},
+		{
+			"MaxOver",
+			MaxOver(ur.USER_ROLE_ID, PartitionBy(ur.USER_ID)),
+			nil,
+			"MAX(ur.user_role_id) OVER (PARTITION BY ur.user_id)",
+			nil,
+		},
+		{
+			"CountDistinct",
+			CountDistinct(ur.USER_ID),
+			nil,
+			"COUNT(DISTINCT ur.user_id)",
+			nil,
+		},
+		{
+			"Sum Distinct",
+			Sum(Distinct(ur.USER_ID)),
+			nil,
+			"SUM(DISTINCT ur.user_id)",
+			nil,
+		},
+		{
+			"Count Filter",
+			Count().Filter(ur.COHORT.EqString("2020")),
+			nil,
+			"COUNT(*) FILTER (WHERE ur.cohort = ?)",
+			[]interface{}{"2020"},
+		},
+		{
+			"Sum Filter variadic predicate",
+			Sum(ur.USER_ID).Filter(And(ur.COHORT.EqString("2020"), ur.ROLE.IsNotNull())),
+			nil,
+			"SUM(ur.user_id) FILTER (WHERE ur.cohort = ? AND ur.role IS NOT NULL)",
+			[]interface{}{"2020"},
+		},
+		{
+			"GroupConcat",
+			GroupConcat(ur.ROLE, ", "),
+			nil,
+			"GROUP_CONCAT(ur.role, ?)",
+			[]interface{}{", "},
+		},
+		{
+			"GroupConcat default separator",
+			GroupConcat(Distinct(ur.ROLE), ","),
+			nil,
+			"GROUP_CONCAT(DISTINCT ur.role)",
+			nil,
+		},
+		{
+			"GroupConcat ordered",
+			GroupConcat(ur.ROLE, ";", ur.ROLE.Desc()).Filter(ur.USER_ID.GtInt(1)),
+			[]string{"ur"},
+			"GROUP_CONCAT(role, ? ORDER BY role DESC) FILTER (WHERE user_id > ?)",
+			[]interface{}{";", 1},
+		},
+		{
+			"JSONGroupArray",
+			JSONGroupArray(ur.USER_ID, ur.CREATED_AT, ur.USER_ROLE_ID.Desc()).Filter(ur.ROLE.IsNotNull()),
+			nil,
+			"json_group_array(ur.user_id ORDER BY ur.created_at, ur.user_role_id DESC) FILTER (WHERE ur.role IS NOT NULL)",
+			nil,
+		},
+		{
+			"JSONGroupObject",
+			JSONGroupObject(ur.ROLE, ur.USER_ID),
+			nil,
+			"json_group_object(ur.role, ur.user_id)",
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.f.AppendSQLExclude(buf, &args, nil, tt.exclude)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+
+	t.Run("Fetch", func(t *testing.T) {
+		if testing.Short() {
+			return
+		}
+		is := is.New(t)
+		db, err := sql.Open("devlab", "AggregateFunctions")
+		is.NoErr(err)
+		defer db.Close()
+		ur := USER_ROLES().As("ur")
+		var filtered, where, distinct int
+		var roles string
+		var ids []int
+		err = SelectRowx(func(row *Row) {
+			filtered = row.Int(Count().Filter(ur.USER_ID.EqInt(16)))
+			distinct = row.Int(CountDistinct(ur.USER_ID))
+			roles = row.String(GroupConcat(Distinct(ur.ROLE), ","))
+			row.ScanJSON(&ids, JSONGroupArray(ur.USER_ID, ur.USER_ID.Desc()).Filter(ur.USER_ID.LeInt(2)))
+		}).From(ur).Fetch(db)
+		is.NoErr(err)
+		err = SelectRowx(func(row *Row) {
+			where = row.Int(Count())
+		}).From(ur).Where(ur.USER_ID.EqInt(16)).Fetch(db)
+		is.NoErr(err)
+		is.True(filtered > 0)
+		is.Equal(where, filtered)
+		is.True(distinct > 0)
+		is.True(roles != "")
+		for i, id := range ids {
+			is.True(id <= 2)
+			if i > 0 {
+				is.True(ids[i-1] >= id)
+			}
+		}
+	})
+}
@@ -0,0 +1,298 @@
+package sq
+
+import (
+	"log"
+	"os"
+	"time"
+)
+
+// LogFlag is a flag that affects the verbosity of the Logger output.
+type LogFlag int
+
+// LogFlags
+const (
+	Linterpolate LogFlag = 1 << iota
+	Lstats
+	Lresults
+	// Lparse
+	Lverbose = Lstats | Lresults
+)
+
+// ExecFlag is a flag that affects the behavior of Exec.
+type ExecFlag int
+
+// ExecFlags
+const (
+	ErowsAffected ExecFlag = 1 << iota
+	// Etransaction makes ExecBatched run all of its statements inside one
+	// transaction.
+	Etransaction
+)
+
+// LogAction indicates which action was performed on a query when a LogFunc is
+// invoked.
+type LogAction string
+
+// LogActions
+const (
+	LogActionToSQL LogAction = "ToSQL"
+	LogActionFetch LogAction = "Fetch"
+	LogActionExec  LogAction = "Exec"
+)
+
+// LogInfo contains the information about a query that is passed to a
+// LogFunc.
+type LogInfo struct {
+	LogFlag LogFlag
+	// LogSkip is the calldepth to pass to (*log.Logger).Output from within the
+	// LogFunc so that the log reports the caller of ToSQL/Fetch/Exec.
+	LogSkip   int
+	Query     string
+	Args      []interface{}
+	Action    LogAction
+	TimeTaken time.Duration
+	Err       error
+	// Fetch
+	RowsFetched int64
+	// Exec
+	ExecFlag     ExecFlag
+	RowsAffected int64
+}
+
+// LogFunc is a function that is called with the LogInfo of a query after it
+// is marshalled with ToSQL, fetched with Fetch or executed with Exec. Unlike
+// Log, it receives each part of the log as a separate field so that it can be
+// fed into a structured logger.
+type LogFunc func(LogInfo)
+
+var defaultLogger = log.New(os.Stdout, "[sq] ", log.Ldate|log.Ltime|log.Lshortfile|log.Lmsgprefix)
+
+// BaseQuery is a common query builder that can transform into a SelectQuery,
+// InsertQuery, UpdateQuery or DeleteQuery depending on the method that you
+// call on it.
+type BaseQuery struct {
+	DB      DB
+	Log     Logger
+	LogFlag LogFlag
+	LogFunc LogFunc
+	CTEs    []CTE
+}
+
+// WithDefaultLog creates a new BaseQuery with the default logger and the LogFlag
+func WithDefaultLog(flag LogFlag) BaseQuery {
+	return BaseQuery{
+		Log:     defaultLogger,
+		LogFlag: flag,
+	}
+}
+
+// WithLogFunc creates a new BaseQuery with the LogFunc.
+func WithLogFunc(fn LogFunc) BaseQuery {
+	return BaseQuery{
+		LogFunc: fn,
+	}
+}
+
+// WithDB creates a new BaseQuery with the DB.
+func WithDB(db DB) BaseQuery {
+	return BaseQuery{
+		DB: db,
+	}
+}
+
+// With creates a new BaseQuery with the CTEs.
+func With(CTEs ...CTE) BaseQuery {
+	return BaseQuery{
+		CTEs: CTEs,
+	}
+}
+
+// WithDefaultLog adds the default logger and the LogFlag to the BaseQuery.
+func (q BaseQuery) WithDefaultLog(flag LogFlag) BaseQuery {
+	q.Log = defaultLogger
+	q.LogFlag = flag
+	return q
+}
+
+// WithLogFunc adds the LogFunc to the BaseQuery.
+func (q BaseQuery) WithLogFunc(fn LogFunc) BaseQuery {
+	q.LogFunc = fn
+	return q
+}
+
+// WithDB adds the DB to the BaseQuery.
+func (q BaseQuery) WithDB(db DB) BaseQuery {
+	q.DB = db
+	return q
+}
+
+// With adds the CTEs to the BaseQuery
+func (q BaseQuery) With(CTEs ...CTE) BaseQuery {
+	q.CTEs = append(q.CTEs, CTEs...)
+	return q
+}
+
+// From transforms the BaseQuery into a SelectQuery.
+func (q BaseQuery) From(table Table) SelectQuery {
+	return SelectQuery{
+		FromTable: table,
+		CTEs:      q.CTEs,
+		DB:        q.DB,
+		Log:       q.Log,
+		LogFlag:   q.LogFlag,
+		LogFunc:   q.LogFunc,
+	}
+}
+
+// Fromx transforms the BaseQuery into a SelectQuery.
+func (q BaseQuery) Fromx(joined JoinedTablesGetter) SelectQuery {
+	return q.From(nil).Fromx(joined)
+}
+
+// Select transforms the BaseQuery into a SelectQuery.
+func (q BaseQuery) Select(fields ...Field) SelectQuery {
+	return SelectQuery{
+		SelectFields: fields,
+		CTEs:         q.CTEs,
+		DB:           q.DB,
+		Log:          q.Log,
+		LogFlag:      q.LogFlag,
+		LogFunc:      q.LogFunc,
+	}
+}
+
+// SelectOne transforms the BaseQuery into a SelectQuery.
+func (q BaseQuery) SelectOne() SelectQuery {
+	return SelectQuery{
+		SelectFields: Fields{FieldLiteral("1")},
+		CTEs:         q.CTEs,
+		DB:           q.DB,
+		Log:          q.Log,
+		LogFlag:      q.LogFlag,
+		LogFunc:      q.LogFunc,
+	}
+}
+
+// SelectAll transforms the BaseQuery into a SelectQuery.
+func (q BaseQuery) SelectAll() SelectQuery {
+	return SelectQuery{
+		SelectFields: Fields{FieldLiteral("*")},
+		CTEs:         q.CTEs,
+		DB:           q.DB,
+		Log:          q.Log,
+		LogFlag:      q.LogFlag,
+		LogFunc:      q.LogFunc,
+	}
+}
+
+// SelectCount transforms the BaseQuery into a SelectQuery.
+func (q BaseQuery) SelectCount() SelectQuery {
+	return SelectQuery{
+		SelectFields: Fields{FieldLiteral("COUNT(*)")},
+		CTEs:         q.CTEs,
+		DB:           q.DB,
+		Log:          q.Log,
+		LogFlag:      q.LogFlag,
+		LogFunc:      q.LogFunc,
+	}
+}
+
+// SelectDistinct transforms the BaseQuery into a SelectQuery.
+func (q BaseQuery) SelectDistinct(fields ...Field) SelectQuery {
+	return SelectQuery{
+		SelectType:   SelectTypeDistinct,
+		SelectFields: fields,
+		CTEs:         q.CTEs,
+		DB:           q.DB,
+		Log:          q.Log,
+		LogFlag:      q.LogFlag,
+		LogFunc:      q.LogFunc,
+	}
+}
+
+// Selectx transforms the BaseQuery into a SelectQuery.
+func (q BaseQuery) Selectx(mapper func(*Row), accumulator func()) SelectQuery {
+	return SelectQuery{
+		RowMapper:   mapper,
+		Accumulator: accumulator,
+		CTEs:        q.CTEs,
+		DB:          q.DB,
+		Log:         q.Log,
+		LogFlag:     q.LogFlag,
+		LogFunc:     q.LogFunc,
+	}
+}
+
+// SelectRowx transforms the BaseQuery into a SelectQuery.
+func (q BaseQuery) SelectRowx(mapper func(*Row)) SelectQuery {
+	return SelectQuery{
+		RowMapper: mapper,
+		CTEs:      q.CTEs,
+		DB:        q.DB,
+		Log:       q.Log,
+		LogFlag:   q.LogFlag,
+		LogFunc:   q.LogFunc,
+	}
+}
+
+// InsertInto transforms the BaseQuery into an InsertQuery.
+func (q BaseQuery) InsertInto(table BaseTable) InsertQuery {
+	return InsertQuery{
+		IntoTable: table,
+		CTEs:      q.CTEs,
+		DB:        q.DB,
+		Log:       q.Log,
+		LogFlag:   q.LogFlag,
+		LogFunc:   q.LogFunc,
+	}
+}
+
+// Update transforms the BaseQuery into an UpdateQuery.
+func (q BaseQuery) Update(table BaseTable) UpdateQuery {
+	return UpdateQuery{
+		UpdateTable: table,
+		CTEs:        q.CTEs,
+		DB:          q.DB,
+		Log:         q.Log,
+		LogFlag:     q.LogFlag,
+		LogFunc:     q.LogFunc,
+	}
+}
+
+// DeleteFrom transforms the BaseQuery into a DeleteQuery.
+func (q BaseQuery) DeleteFrom(table BaseTable) DeleteQuery {
+	return DeleteQuery{
+		FromTable: table,
+		CTEs:      q.CTEs,
+		DB:        q.DB,
+		Log:       q.Log,
+		LogFlag:   q.LogFlag,
+		LogFunc:   q.LogFunc,
+	}
+}
+
+// Union transforms the BaseQuery into a VariadicQuery.
+func (q BaseQuery) Union(queries ...Query) VariadicQuery {
+	return VariadicQuery{
+		topLevel: true,
+		Operator: QueryUnion,
+		Queries:  queries,
+		DB:       q.DB,
+		Log:      q.Log,
+		LogFlag:  q.LogFlag,
+		LogFunc:  q.LogFunc,
+	}
+}
+
+// UnionAll transforms the BaseQuery into a VariadicQuery.
+func (q BaseQuery) UnionAll(queries ...Query) VariadicQuery {
+	return VariadicQuery{
+		topLevel: true,
+		Operator: QueryUnionAll,
+		Queries:  queries,
+		DB:       q.DB,
+		Log:      q.Log,
+		LogFlag:  q.LogFlag,
+		LogFunc:  q.LogFunc,
+	}
+}
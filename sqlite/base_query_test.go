@@ -0,0 +1,164 @@
+package sq
+
+import (
+	"database/sql"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestBaseQuery(t *testing.T) {
+	is := is.New(t)
+
+	// (BaseQuery).With will append CTEs, not overwrite it
+	q := With(CTE{}).With(CTE{}, CTE{}).With(CTE{})
+	is.Equal(4, len(q.CTEs))
+
+	var base BaseQuery
+	var buf = &strings.Builder{}
+	var args []interface{}
+	var sel SelectQuery
+	var ins InsertQuery
+	var upd UpdateQuery
+	var del DeleteQuery
+
+	// With
+	base = With(CTE{}, CTE{}, CTE{})
+	is.Equal(3, len(base.CTEs))
+
+	// WithDefaultLog
+	base = WithDefaultLog(Lstats).WithDefaultLog(Lstats)
+	is.Equal(defaultLogger, base.Log)
+	is.Equal(Lstats, base.LogFlag)
+
+	// WithLogFunc
+	base = WithLogFunc(func(LogInfo) {}).WithDB(nil)
+	is.True(base.LogFunc != nil)
+
+	// SelectOne
+	sel = BaseQuery{}.SelectOne()
+	buf.Reset()
+	sel.AppendSQL(buf, &args, nil)
+	is.Equal("SELECT 1", buf.String())
+
+	// SelectAll
+	sel = BaseQuery{}.SelectAll()
+	buf.Reset()
+	sel.AppendSQL(buf, &args, nil)
+	is.Equal("SELECT *", buf.String())
+
+	// SelectCount
+	sel = BaseQuery{}.SelectCount()
+	buf.Reset()
+	sel.AppendSQL(buf, &args, nil)
+	is.Equal("SELECT COUNT(*)", buf.String())
+
+	// SelectDistinct
+	sel = BaseQuery{}.SelectDistinct()
+	buf.Reset()
+	sel.AppendSQL(buf, &args, nil)
+	is.Equal("SELECT DISTINCT", buf.String())
+
+	// Selectx
+	mapper := func(_ *Row) {}
+	accumulator := func() {}
+	sel = BaseQuery{}.Selectx(mapper, accumulator)
+	buf.Reset()
+	sel.AppendSQL(buf, &args, nil)
+	is.Equal(mapper, sel.RowMapper)
+	is.Equal(accumulator, sel.Accumulator)
+
+	// SelectRowx
+	sel = BaseQuery{}.SelectRowx(mapper)
+	buf.Reset()
+	sel.AppendSQL(buf, &args, nil)
+	is.Equal(mapper, sel.RowMapper)
+	is.Equal(nil, sel.Accumulator)
+
+	// InsertInto
+	ins = BaseQuery{}.InsertInto(nil)
+	buf.Reset()
+	ins.AppendSQL(buf, &args, nil)
+	is.Equal("INSERT INTO NULL", buf.String())
+
+	// Update
+	upd = BaseQuery{}.Update(nil)
+	buf.Reset()
+	upd.AppendSQL(buf, &args, nil)
+	is.Equal("UPDATE NULL", buf.String())
+
+	// DeleteFrom
+	del = BaseQuery{}.DeleteFrom(nil)
+	buf.Reset()
+	del.AppendSQL(buf, &args, nil)
+	is.Equal("DELETE FROM NULL", buf.String())
+}
+
+func TestBaseQuery_LogFunc(t *testing.T) {
+	is := is.New(t)
+	var info LogInfo
+	logBuf := &strings.Builder{}
+	logger := log.New(logBuf, "", log.Lshortfile)
+	logFunc := func(i LogInfo) {
+		info = i
+		logger.Output(i.LogSkip, i.Query)
+	}
+
+	// ToSQL
+	u := USERS().As("u")
+	q := WithLogFunc(logFunc).WithDefaultLog(Lverbose)
+	q.From(u).Where(u.USER_ID.EqInt(1)).Select(u.USER_ID).ToSQL()
+	is.Equal(LogActionToSQL, info.Action)
+	is.Equal(Lverbose, info.LogFlag)
+	is.Equal("SELECT u.user_id FROM main.users AS u WHERE u.user_id = ?", info.Query)
+	is.Equal([]interface{}{1}, info.Args)
+	is.True(strings.Contains(logBuf.String(), "base_query_test.go"))
+	q.InsertInto(u).Columns(u.DISPLAYNAME).Values("bob").ToSQL()
+	is.Equal("INSERT INTO main.users AS u (displayname) VALUES (?)", info.Query)
+	q.Update(u).Set(u.DISPLAYNAME.SetString("bob")).ToSQL()
+	is.Equal("UPDATE main.users AS u SET displayname = ?", info.Query)
+	q.DeleteFrom(u).ToSQL()
+	is.Equal("DELETE FROM main.users AS u", info.Query)
+	// VariadicQuery does not inherit the LogFunc of its queries
+	info = LogInfo{}
+	Union(q.From(u).Select(u.USER_ID), q.From(u).Select(u.USER_ID)).ToSQL()
+	is.Equal("", info.Query)
+
+	if testing.Short() {
+		return
+	}
+	db, err := sql.Open("devlab", "BaseQuery_LogFunc")
+	is.NoErr(err)
+	defer db.Close()
+
+	// Fetch
+	logBuf.Reset()
+	var userIDs []int
+	var userID int
+	err = q.From(u).Where(u.USER_ID.LeInt(3)).Selectx(func(row *Row) {
+		userID = row.Int(u.USER_ID)
+	}, func() {
+		userIDs = append(userIDs, userID)
+	}).Fetch(db)
+	is.NoErr(err)
+	is.Equal(LogActionFetch, info.Action)
+	is.Equal(int64(len(userIDs)), info.RowsFetched)
+	is.Equal([]interface{}{3}, info.Args)
+	is.True(strings.Contains(logBuf.String(), "base_query_test.go"))
+
+	// Exec
+	rowsAffected, err := q.DeleteFrom(u).Where(u.USER_ID.EqInt(-1)).Exec(db, ErowsAffected)
+	is.NoErr(err)
+	is.Equal(LogActionExec, info.Action)
+	is.Equal(ErowsAffected, info.ExecFlag)
+	is.Equal(rowsAffected, info.RowsAffected)
+	is.Equal("DELETE FROM main.users AS u WHERE u.user_id = ?", info.Query)
+	is.Equal([]interface{}{-1}, info.Args)
+
+	// Errors are reported
+	err = q.From(u).Select(u.USER_ID).Fetch(nil)
+	is.True(err != nil)
+	is.Equal(err, info.Err)
+}
@@ -0,0 +1,145 @@
+package sq
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestCompiledQuery_ToSQL(t *testing.T) {
+	type TT struct {
+		description string
+		q           CompiledQuery
+		wantQuery   string
+		wantArgs    []interface{}
+		wantParams  map[string][]int
+	}
+	u := USERS().As("u")
+	tests := []TT{
+		{
+			"SelectQuery",
+			Select(u.USER_ID).From(u).Where(u.DISPLAYNAME.EqString("bob"), Eq(u.EMAIL, Param("email"))).Compile(),
+			"SELECT u.user_id FROM main.users AS u WHERE u.displayname = ? AND u.email = ?",
+			[]interface{}{"bob", Param("email")},
+			map[string][]int{"email": {1}},
+		},
+		{
+			"SelectQuery mapper",
+			From(u).Where(Eq(u.USER_ID, Param("id"))).SelectRowx(func(row *Row) {
+				row.Int(u.USER_ID)
+				row.String(u.EMAIL)
+			}).Compile(),
+			"SELECT u.user_id, u.email FROM main.users AS u WHERE u.user_id = ?",
+			[]interface{}{Param("id")},
+			map[string][]int{"id": {0}},
+		},
+		{
+			"InsertQuery",
+			InsertInto(u).Columns(u.DISPLAYNAME, u.EMAIL).Values(Param("name"), Param("email")).Returning(u.USER_ID).Compile(),
+			"INSERT INTO main.users AS u (displayname, email) VALUES (?, ?) RETURNING u.user_id",
+			[]interface{}{Param("name"), Param("email")},
+			map[string][]int{"name": {0}, "email": {1}},
+		},
+		{
+			"UpdateQuery",
+			Update(u).Set(u.DISPLAYNAME.Set(Param("name"))).Where(Or(Eq(u.USER_ID, Param("id")), Eq(u.USER_ID, Param("id")))).Compile(),
+			"UPDATE main.users AS u SET displayname = ? WHERE u.user_id = ? OR u.user_id = ?",
+			[]interface{}{Param("name"), Param("id"), Param("id")},
+			map[string][]int{"name": {0}, "id": {1, 2}},
+		},
+		{
+			"DeleteQuery",
+			DeleteFrom(u).Where(Eq(u.USER_ID, Param("id"))).Compile(),
+			"DELETE FROM main.users AS u WHERE u.user_id = ?",
+			[]interface{}{Param("id")},
+			map[string][]int{"id": {0}},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			gotQuery, gotArgs := tt.q.ToSQL()
+			is.Equal(tt.wantQuery, gotQuery)
+			is.Equal(tt.wantArgs, gotArgs)
+			is.Equal(tt.wantParams, tt.q.Params)
+		})
+	}
+}
+
+func TestCompiledQuery_Bind(t *testing.T) {
+	is := is.New(t)
+	u := USERS().As("u")
+	q := Update(u).
+		Set(u.DISPLAYNAME.Set(Param("name"))).
+		Where(Or(Eq(u.USER_ID, Param("id")), Eq(u.USER_ID, Param("id")))).
+		Compile()
+
+	q1 := q.Bind("id", 1).Bind("name", "alice")
+	_, args := q1.ToSQL()
+	is.Equal([]interface{}{"alice", 1, 1}, args)
+
+	// rebinding does not affect the previously bound CompiledQuery
+	q2 := q1.Bind("id", 2)
+	_, args = q2.ToSQL()
+	is.Equal([]interface{}{"alice", 2, 2}, args)
+	_, args = q1.ToSQL()
+	is.Equal([]interface{}{"alice", 1, 1}, args)
+
+	// binding a nonexistent param is a no-op
+	q3 := q.Bind("nonexistent", 3)
+	_, args = q3.ToSQL()
+	is.Equal([]interface{}{Param("name"), Param("id"), Param("id")}, args)
+
+	// unbound params cannot be sent to the database
+	_, err := Param("id").Value()
+	is.True(err != nil)
+}
+
+func TestCompiledQuery_Fetch(t *testing.T) {
+	if testing.Short() {
+		return
+	}
+	is := is.New(t)
+	db, err := sql.Open("devlab", "CompiledQuery_Fetch")
+	is.NoErr(err)
+	defer db.Close()
+	u := USERS()
+
+	user := &User{}
+	var users []User
+	q := WithDefaultLog(Lverbose).
+		From(u).
+		Where(u.USER_ID.Le(NumberFieldf("?", Param("id")))).
+		OrderBy(u.USER_ID).
+		Selectx(user.RowMapper(u), func() { users = append(users, *user) }).
+		Compile()
+
+	// Missing DB
+	err = q.Bind("id", 1).Fetch(nil)
+	is.True(err != nil)
+
+	// Unbound param
+	err = q.Fetch(db)
+	is.True(err != nil)
+
+	err = q.Bind("id", 5).Fetch(db)
+	is.NoErr(err)
+	is.Equal(5, len(users))
+
+	users = users[:0]
+	err = q.Bind("id", 10).Fetch(db)
+	is.NoErr(err)
+	is.Equal(10, len(users))
+
+	// Exec
+	rowsAffected, err := DeleteFrom(u).
+		Where(u.USER_ID.EqInt(-999999), Eq(u.EMAIL, Param("email"))).
+		Compile().
+		Bind("email", "nobody@example.com").
+		Exec(db, ErowsAffected)
+	is.NoErr(err)
+	is.Equal(int64(0), rowsAffected)
+}
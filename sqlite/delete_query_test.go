@@ -0,0 +1,287 @@
+package sq
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestDeleteQuery_ToSQL(t *testing.T) {
+	type TT struct {
+		description string
+		q           DeleteQuery
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	u := USERS().As("u")
+	tests := []TT{
+		{"empty", DeleteQuery{}, "DELETE FROM NULL", nil},
+		{"From", WithDefaultLog(Linterpolate).DeleteFrom(u), "DELETE FROM main.users AS u", nil},
+		func() TT {
+			var tt TT
+			tt.description = "assorted"
+			cte1 := Select(u.USER_ID).From(u).Where(Bool(true)).CTE("cte1")
+			tt.q = WithDefaultLog(Lverbose).
+				With(cte1).
+				DeleteFrom(u).
+				Where(u.USER_ID.In(Select(cte1["user_id"]).From(cte1))).
+				Returning(u.USER_ID, u.DISPLAYNAME, u.EMAIL)
+			tt.wantQuery = "WITH cte1 AS (SELECT u.user_id FROM main.users AS u WHERE ?)" +
+				" DELETE FROM main.users AS u" +
+				" WHERE u.user_id IN (SELECT cte1.user_id FROM cte1)" +
+				" RETURNING u.user_id, u.displayname, u.email"
+			tt.wantArgs = []interface{}{true}
+			return tt
+		}(),
+		func() TT {
+			desc := "aliasless table"
+			u := USERS()
+			q := WithDefaultLog(0).DeleteFrom(u)
+			wantQuery := "DELETE FROM main.users"
+			return TT{desc, q, wantQuery, nil}
+		}(),
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			var _ Query = tt.q
+			gotQuery, gotArgs := tt.q.ToSQL()
+			is.Equal(tt.wantQuery, gotQuery)
+			is.Equal(tt.wantArgs, gotArgs)
+		})
+	}
+}
+
+func TestDeleteQuery_Fetch(t *testing.T) {
+	if testing.Short() {
+		return
+	}
+	is := is.New(t)
+	db, err := sql.Open("devlab", "DeleteQuery_Fetch")
+	is.NoErr(err)
+	defer db.Close()
+	s := SUBMISSIONS()
+
+	// Missing DB
+	err = DeleteFrom(s).
+		ReturningRowx(func(row *Row) {}).
+		Fetch(nil)
+	is.True(err != nil)
+
+	// SQL syntax error
+	// use a tempDB so we don't foul up the current db transaction with the error
+	tempDB, err := sql.Open("devlab", randomString(8))
+	is.NoErr(err)
+	var submissionID int
+	err = WithDefaultLog(Linterpolate).
+		WithDB(tempDB).
+		DeleteFrom(s).
+		ReturningRowx(func(row *Row) {
+			row.ScanInto(&submissionID, s.SUBMISSION_ID.Asc().NullsLast())
+		}).
+		Fetch(nil)
+	is.True(err != nil)
+	tempDB.Close()
+
+	// No mapper
+	err = WithDB(db).
+		DeleteFrom(s).
+		Fetch(nil)
+	is.True(err != nil)
+
+	// Empty mapper
+	err = WithDB(db).
+		DeleteFrom(s).
+		Where(s.SUBMISSION_ID.EqInt(0)).
+		ReturningRowx(func(row *Row) {}).
+		Fetch(nil)
+	is.NoErr(err)
+
+	// Wrong Scan type
+	tempDB, err = sql.Open("devlab", randomString(8))
+	is.NoErr(err)
+	err = WithDefaultLog(Lverbose).
+		WithDB(tempDB).
+		DeleteFrom(s).
+		Where(s.SUBMISSION_ID.EqInt(1)).
+		ReturningRowx(func(row *Row) {
+			row.ScanInto(&submissionID, s.CREATED_AT)
+		}).
+		Fetch(nil)
+	is.True(err != nil)
+	tempDB.Close()
+
+	// sql.ErrNoRows
+	err = WithDefaultLog(Linterpolate).
+		WithDB(db).
+		DeleteFrom(s).
+		Where(s.SUBMISSION_ID.EqInt(-99999)).
+		ReturningRowx(func(row *Row) {
+			row.Int(s.SUBMISSION_ID)
+		}).
+		Fetch(nil)
+	is.True(errors.Is(err, sql.ErrNoRows))
+
+	// simulate timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	err = WithDefaultLog(Lverbose).
+		WithDB(db).
+		DeleteFrom(s).
+		Where(s.SUBMISSION_ID.EqInt(0)).
+		ReturningRowx(func(row *Row) {}).
+		FetchContext(ctx, nil)
+	is.True(errors.Is(err, context.DeadlineExceeded))
+
+	// RowMapper
+	tempDB, err = sql.Open("devlab", randomString(8))
+	is.NoErr(err)
+	err = WithDefaultLog(Lverbose).
+		WithDB(tempDB).
+		DeleteFrom(s).
+		Where(s.SUBMISSION_ID.EqInt(1)).
+		ReturningRowx(func(row *Row) {
+			submissionID = row.Int(s.SUBMISSION_ID)
+		}).
+		Fetch(nil)
+	is.NoErr(err)
+	is.Equal(1, submissionID)
+	tempDB.Close()
+
+	// Accumulator
+	tempDB, err = sql.Open("devlab", randomString(8))
+	is.NoErr(err)
+	var submissionIDs []int
+	err = WithDefaultLog(Lverbose).
+		WithDB(tempDB).
+		DeleteFrom(s).
+		Where(s.SUBMISSION_ID.In([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})).
+		Returningx(func(row *Row) {
+			submissionID = row.Int(s.SUBMISSION_ID)
+		}, func() {
+			submissionIDs = append(submissionIDs, submissionID)
+		}).
+		Fetch(nil)
+	is.NoErr(err)
+	is.Equal(10, len(submissionIDs))
+	tempDB.Close()
+
+	// Panic with ExitPeacefully
+	submissionIDs = submissionIDs[:0]
+	err = WithDefaultLog(Linterpolate).
+		WithDB(db).
+		DeleteFrom(s).
+		Where(s.SUBMISSION_ID.In([]int{3, 4})).
+		Returningx(func(row *Row) {
+			submissionID = row.Int(s.SUBMISSION_ID)
+		}, func() {
+			panic(ExitPeacefully)
+		}).
+		Fetch(nil)
+	is.NoErr(err)
+	is.Equal(0, len(submissionIDs))
+
+	// Panic with any other ExitCode
+	submissionIDs = submissionIDs[:0]
+	err = WithDefaultLog(Linterpolate).
+		WithDB(db).
+		DeleteFrom(s).
+		Where(s.SUBMISSION_ID.In([]int{5, 6})).
+		Returningx(func(row *Row) {
+			submissionID = row.Int(s.SUBMISSION_ID)
+		}, func() {
+			panic(ExitCode(1))
+		}).
+		Fetch(nil)
+	is.True(errors.Is(err, ExitCode(1)))
+
+	// Panic with error
+	ErrTest := errors.New("this is a test error")
+	submissionIDs = submissionIDs[:0]
+	err = WithDefaultLog(Linterpolate).
+		WithDB(db).
+		DeleteFrom(s).
+		Where(s.SUBMISSION_ID.In([]int{7, 8})).
+		Returningx(func(row *Row) {
+			submissionID = row.Int(s.SUBMISSION_ID)
+		}, func() {
+			panic(ErrTest)
+		}).
+		Fetch(nil)
+	is.True(errors.Is(err, ErrTest))
+
+	// Panic with 0
+	submissionIDs = submissionIDs[:0]
+	err = WithDefaultLog(Linterpolate).
+		WithDB(db).
+		DeleteFrom(s).
+		Where(s.SUBMISSION_ID.In([]int{9, 10})).
+		Returningx(func(row *Row) {
+			submissionID = row.Int(s.SUBMISSION_ID)
+		}, func() {
+			panic(0)
+		}).
+		Fetch(nil)
+	is.Equal(fmt.Errorf("0").Error(), err.Error())
+}
+
+func TestDeleteQuery_Exec(t *testing.T) {
+	if testing.Short() {
+		return
+	}
+	is := is.New(t)
+	db, err := sql.Open("devlab", "DeleteQuery_Exec")
+	is.NoErr(err)
+	defer db.Close()
+	s := SUBMISSIONS()
+
+	// Missing DB
+	_, err = DeleteFrom(s).
+		ReturningRowx(func(row *Row) {}).
+		Exec(nil, 0)
+	is.True(err != nil)
+
+	// SQL syntax error
+	// use a tempDB so we don't foul up the current db transaction with the error
+	tempDB, err := sql.Open("devlab", randomString(8))
+	is.NoErr(err)
+	_, err = WithDefaultLog(Linterpolate).
+		WithDB(tempDB).
+		DeleteFrom(s).
+		Returning(Fieldf("ERROR")).
+		Exec(nil, ErowsAffected)
+	is.True(err != nil)
+	tempDB.Close()
+
+	// simulate timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	_, err = WithDB(db).
+		DeleteFrom(s).
+		Where(s.SUBMISSION_ID.EqInt(0)).
+		ExecContext(ctx, nil, ErowsAffected)
+	is.True(errors.Is(err, context.DeadlineExceeded))
+
+	// rowsAffected
+	rowsAffected, err := WithDefaultLog(Lverbose).
+		WithDB(db).
+		DeleteFrom(s).
+		Where(s.SUBMISSION_ID.EqInt(1)).
+		Exec(nil, ErowsAffected)
+	is.NoErr(err)
+	is.Equal(int64(1), rowsAffected)
+
+	rowsAffected, err = WithDefaultLog(Lverbose).
+		WithDB(db).
+		DeleteFrom(s).
+		Where(s.SUBMISSION_ID.EqInt(2)).
+		Exec(nil, ErowsAffected)
+	is.NoErr(err)
+	is.Equal(int64(1), rowsAffected)
+}
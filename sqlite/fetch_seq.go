@@ -0,0 +1,60 @@
+//go:build go1.23
+
+package sq
+
+import (
+	"context"
+	"iter"
+)
+
+// FetchSeq will run the SelectQuery with the given DB and return an iterator
+// over the results of calling the mapper on each row. Any mapper and
+// accumulator already set on the query are ignored. The rows are read lazily
+// and closed when the loop ends, even if it ends early with break. If an
+// error occurs it is yielded as the last element of the iterator. FetchSeq is
+// only available from Go 1.23, which added range over functions.
+//
+//	for user, err := range sq.FetchSeq(db, q, mapUser) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(user)
+//	}
+func FetchSeq[T any](db DB, q SelectQuery, mapper func(*Row) T) iter.Seq2[T, error] {
+	return FetchSeqContext(nil, db, q, mapper)
+}
+
+// FetchSeqContext will run the SelectQuery with the given DB and context and
+// return an iterator over the results of calling the mapper on each row.
+func FetchSeqContext[T any](ctx context.Context, db DB, q SelectQuery, mapper func(*Row) T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var item T
+		q.RowMapper = func(row *Row) {
+			item = mapper(row)
+		}
+		q.Accumulator = nil
+		q.logSkip += 1
+		cursor, err := q.Iterate(ctx, db)
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+		defer cursor.Close()
+		for cursor.Next() {
+			err = cursor.Scan()
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if !yield(item, nil) {
+				return
+			}
+		}
+		if err = cursor.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+//go:build go1.23
+
+package sq
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestFetchSeq(t *testing.T) {
+	is := is.New(t)
+
+	// A query that cannot be run yields the zero value rather than whatever
+	// the mapper returned while its fields were being collected
+	var yielded []int
+	for n, err := range FetchSeq(nil, From(USERS()), func(row *Row) int {
+		return 1 + row.Int(USERS().USER_ID)
+	}) {
+		is.True(err != nil)
+		yielded = append(yielded, n)
+	}
+	is.Equal([]int{0}, yielded)
+
+	if testing.Short() {
+		return
+	}
+	db, err := sql.Open("devlab", "FetchSeq")
+	is.NoErr(err)
+	defer db.Close()
+	u := USERS()
+	mapUser := func(row *Row) User {
+		var user User
+		user.UserID = row.Int(u.USER_ID)
+		user.Displayname = row.String(u.DISPLAYNAME)
+		return user
+	}
+
+	// Every row
+	var users []User
+	for user, err := range FetchSeq(db, From(u).Where(u.USER_ID.LeInt(5)).OrderBy(u.USER_ID), mapUser) {
+		is.NoErr(err)
+		users = append(users, user)
+	}
+	is.Equal(5, len(users))
+	is.Equal(1, users[0].UserID)
+	is.Equal(5, users[4].UserID)
+
+	// Break early
+	users = users[:0]
+	for user, err := range FetchSeq(db, From(u).OrderBy(u.USER_ID), mapUser) {
+		is.NoErr(err)
+		users = append(users, user)
+		if len(users) == 3 {
+			break
+		}
+	}
+	is.Equal(3, len(users))
+
+	// No rows
+	var count int
+	for _, err := range FetchSeq(db, From(u).Where(u.USER_ID.EqInt(-1)), mapUser) {
+		is.NoErr(err)
+		count++
+	}
+	is.Equal(0, count)
+
+	// Errors are yielded
+	var errs []error
+	for _, err := range FetchSeq(db, From(u), func(row *Row) int {
+		var wrong int
+		row.ScanInto(&wrong, u.DISPLAYNAME)
+		return wrong
+	}) {
+		errs = append(errs, err)
+	}
+	is.Equal(1, len(errs))
+	is.True(errs[0] != nil)
+}
@@ -0,0 +1,665 @@
+package sq
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// InsertQuery represents an INSERT query.
+type InsertQuery struct {
+	nested bool
+	// WITH
+	CTEs []CTE
+	// INSERT INTO
+	IntoTable     BaseTable
+	InsertColumns Fields
+	// VALUES
+	RowValues RowValues
+	// SELECT
+	SelectQuery *SelectQuery
+	// ON CONFLICT
+	HandleConflict      bool
+	ConflictFields      Fields
+	ConflictPredicate   VariadicPredicate
+	Resolution          Assignments
+	ResolutionPredicate VariadicPredicate
+	// RETURNING
+	ReturningFields Fields
+	// DB
+	DB           DB
+	ColumnMapper func(*Column)
+	RowMapper    func(*Row)
+	Accumulator  func()
+	// Logging
+	Log     Logger
+	LogFlag LogFlag
+	LogFunc LogFunc
+	logSkip int
+}
+
+// ToSQL marshals the InsertQuery into a query string and args slice.
+func (q InsertQuery) ToSQL() (query string, args []interface{}) {
+	defer func() {
+		if r := recover(); r != nil {
+			args = []interface{}{r}
+		}
+	}()
+	q.logSkip += 1
+	buf := &strings.Builder{}
+	q.AppendSQL(buf, &args, nil)
+	if q.LogFunc != nil {
+		q.LogFunc(LogInfo{
+			LogFlag: q.LogFlag,
+			LogSkip: q.logSkip + 2,
+			Query:   buf.String(),
+			Args:    args,
+			Action:  LogActionToSQL,
+		})
+	}
+	return buf.String(), args
+}
+
+// AppendSQL marshals the InsertQuery into a buffer and args slice. Do not call
+// this as an end user, use ToSQL instead. AppendSQL may panic if you wrote
+// panic code in your ColumnMapper, it is only exported to satisfy the Query
+// interface.
+func (q InsertQuery) AppendSQL(buf *strings.Builder, args *[]interface{}, params map[string]int) {
+	var excludedTableQualifiers []string
+	if q.ColumnMapper != nil {
+		col := &Column{mode: colmodeInsert}
+		q.ColumnMapper(col)
+		q.InsertColumns = col.insertColumns
+		q.RowValues = col.rowValues
+	}
+	// WITH
+	if !q.nested && q.SelectQuery != nil {
+		appendCTEs(buf, args, q.CTEs, q.SelectQuery.FromTable, q.SelectQuery.JoinTables)
+	}
+	// INSERT INTO
+	buf.WriteString("INSERT INTO ")
+	if q.IntoTable == nil {
+		buf.WriteString("NULL")
+	} else {
+		q.IntoTable.AppendSQL(buf, args, nil)
+		name := q.IntoTable.GetName()
+		alias := q.IntoTable.GetAlias()
+		if alias != "" {
+			buf.WriteString(" AS ")
+			buf.WriteString(alias)
+			excludedTableQualifiers = append(excludedTableQualifiers, alias)
+		} else {
+			excludedTableQualifiers = append(excludedTableQualifiers, name)
+		}
+	}
+	if len(q.InsertColumns) > 0 {
+		buf.WriteString(" (")
+		q.InsertColumns.AppendSQLExclude(buf, args, nil, excludedTableQualifiers)
+		buf.WriteString(")")
+	}
+	// VALUES/SELECT
+	switch {
+	case len(q.RowValues) > 0:
+		buf.WriteString(" VALUES ")
+		q.RowValues.AppendSQL(buf, args, nil)
+	case q.SelectQuery != nil:
+		buf.WriteString(" ")
+		selectQuery := *q.SelectQuery
+		selectQuery.nested = true
+		// SQLite cannot tell an ON CONFLICT clause apart from a JOIN
+		// constraint unless the SELECT has a WHERE clause
+		if q.HandleConflict && len(selectQuery.WherePredicate.Predicates) == 0 {
+			selectQuery.WherePredicate.Predicates = []Predicate{Predicatef("TRUE")}
+		}
+		selectQuery.AppendSQL(buf, args, nil)
+	}
+	// ON CONFLICT
+	var noConflict bool
+	switch {
+	case q.HandleConflict:
+		buf.WriteString(" ON CONFLICT")
+		if len(q.ConflictFields) > 0 {
+			buf.WriteString(" (")
+			q.ConflictFields.AppendSQLExclude(buf, args, nil, excludedTableQualifiers)
+			buf.WriteString(")")
+			if len(q.ConflictPredicate.Predicates) > 0 {
+				buf.WriteString(" WHERE ")
+				q.ConflictPredicate.toplevel = true
+				q.ConflictPredicate.AppendSQLExclude(buf, args, nil, excludedTableQualifiers)
+			}
+		}
+	default:
+		noConflict = true
+	}
+	switch {
+	case noConflict:
+		break
+	case len(q.Resolution) > 0:
+		buf.WriteString(" DO UPDATE SET ")
+		q.Resolution.AppendSQLExclude(buf, args, nil, excludedTableQualifiers)
+		if len(q.ResolutionPredicate.Predicates) > 0 {
+			buf.WriteString(" WHERE ")
+			q.ResolutionPredicate.toplevel = true
+			q.ResolutionPredicate.AppendSQLExclude(buf, args, nil, nil)
+		}
+	default:
+		buf.WriteString(" DO NOTHING")
+	}
+	// RETURNING
+	if len(q.ReturningFields) > 0 {
+		buf.WriteString(" RETURNING ")
+		q.ReturningFields.AppendSQLExcludeWithAlias(buf, args, nil, nil)
+	}
+	if !q.nested {
+		if q.Log != nil {
+			query := buf.String()
+			var logOutput string
+			switch {
+			case Lstats&q.LogFlag != 0:
+				logOutput = "\n----[ Executing query ]----\n" + query + " " + fmt.Sprint(*args) +
+					"\n----[ with bind values ]----\n" + questionInterpolate(query, *args...)
+			case Linterpolate&q.LogFlag != 0:
+				logOutput = questionInterpolate(query, *args...)
+			default:
+				logOutput = query + " " + fmt.Sprint(*args)
+			}
+			switch q.Log.(type) {
+			case *log.Logger:
+				_ = q.Log.Output(q.logSkip+2, logOutput)
+			default:
+				_ = q.Log.Output(q.logSkip+1, logOutput)
+			}
+		}
+	}
+}
+
+// InsertInto creates a new InsertQuery.
+func InsertInto(table BaseTable) InsertQuery {
+	return InsertQuery{
+		IntoTable: table,
+	}
+}
+
+// With appends a list of CTEs into the InsertQuery.
+func (q InsertQuery) With(ctes ...CTE) InsertQuery {
+	q.CTEs = append(q.CTEs, ctes...)
+	return q
+}
+
+// InsertInto sets the insert table for the InsertQuery.
+func (q InsertQuery) InsertInto(table BaseTable) InsertQuery {
+	q.IntoTable = table
+	return q
+}
+
+// Columns sets the insert columns for the InsertQuery.
+func (q InsertQuery) Columns(fields ...Field) InsertQuery {
+	q.InsertColumns = fields
+	return q
+}
+
+// Values appends a new RowValue to the InsertQuery.
+func (q InsertQuery) Values(values ...interface{}) InsertQuery {
+	q.RowValues = append(q.RowValues, values)
+	return q
+}
+
+// Valuesx sets the column mapper for the InsertQuery.
+func (q InsertQuery) Valuesx(mapper func(*Column)) InsertQuery {
+	q.ColumnMapper = mapper
+	return q
+}
+
+// Select adds a SelectQuery to the InsertQuery.
+func (q InsertQuery) Select(selectQuery SelectQuery) InsertQuery {
+	q.SelectQuery = &selectQuery
+	return q
+}
+
+// OnConflict specifies which Fields may potentially experience a conflict.
+func (q InsertQuery) OnConflict(fields ...Field) InsertConflict {
+	q.HandleConflict = true
+	q.ConflictFields = fields
+	return InsertConflict{insertQuery: &q}
+}
+
+// InsertConflict holds the intermediate state of an InsertQuery that may
+// experience a conflict.
+type InsertConflict struct{ insertQuery *InsertQuery }
+
+// Where appends the predicates to the WHERE clause of the InsertQuery conflict.
+func (c InsertConflict) Where(predicates ...Predicate) InsertConflict {
+	c.insertQuery.ConflictPredicate.Predicates = append(c.insertQuery.ConflictPredicate.Predicates, predicates...)
+	return c
+}
+
+// DoNothing indicates that nothing should be done in case of any conflicts.
+func (c InsertConflict) DoNothing() InsertQuery {
+	if c.insertQuery == nil {
+		return InsertQuery{}
+	}
+	return *c.insertQuery
+}
+
+// DoUpdateSet specifies the assignments to be done in case of a conflict.
+func (c InsertConflict) DoUpdateSet(assignments ...Assignment) InsertQuery {
+	if c.insertQuery == nil {
+		return InsertQuery{}
+	}
+	c.insertQuery.Resolution = assignments
+	return *c.insertQuery
+}
+
+// Excluded wraps a field to simulate the EXCLUDED.field SQLite construct for the
+// ON CONFLICT DO UPDATE SET clause.
+func Excluded(field Field) CustomField {
+	return CustomField{
+		Format: "EXCLUDED." + field.GetName(),
+	}
+}
+
+// Where appends the predicates to the WHERE clause of InsertQuery conflict resolution.
+func (q InsertQuery) Where(predicates ...Predicate) InsertQuery {
+	q.ResolutionPredicate.Predicates = append(q.ResolutionPredicate.Predicates, predicates...)
+	return q
+}
+
+// Returning appends the fields to the RETURNING clause of the InsertQuery.
+func (q InsertQuery) Returning(fields ...Field) InsertQuery {
+	q.ReturningFields = append(q.ReturningFields, fields...)
+	return q
+}
+
+// ReturningOne sets the RETURNING clause to RETURNING 1 in the InsertQuery.
+func (q InsertQuery) ReturningOne() InsertQuery {
+	q.ReturningFields = Fields{FieldLiteral("1")}
+	return q
+}
+
+// Returningx sets the rowmapper and accumulator function of the InsertQuery.
+func (q InsertQuery) Returningx(mapper func(*Row), accumulator func()) InsertQuery {
+	q.RowMapper = mapper
+	q.Accumulator = accumulator
+	return q
+}
+
+// ReturningRowx sets the rowmapper function of the InsertQuery.
+func (q InsertQuery) ReturningRowx(mapper func(*Row)) InsertQuery {
+	q.RowMapper = mapper
+	return q
+}
+
+// Fetch will run InsertQuery with the given DB. It then maps the results based
+// on the mapper function (and optionally runs the accumulator function).
+func (q InsertQuery) Fetch(db DB) (err error) {
+	q.logSkip += 1
+	return q.FetchContext(nil, db)
+}
+
+// FetchContext will run InsertQuery with the given DB and context. It then
+// maps the results based on the mapper function (and optionally runs the
+// accumulator function).
+func (q InsertQuery) FetchContext(ctx context.Context, db DB) (err error) {
+	logBuf := &strings.Builder{}
+	start := time.Now()
+	var rowcount int
+	var logQuery string
+	var logArgs []interface{}
+	if q.LogFunc != nil {
+		defer func() {
+			q.LogFunc(LogInfo{
+				LogFlag:     q.LogFlag,
+				LogSkip:     q.logSkip + 3,
+				Query:       logQuery,
+				Args:        logArgs,
+				Action:      LogActionFetch,
+				TimeTaken:   time.Since(start),
+				Err:         err,
+				RowsFetched: int64(rowcount),
+			})
+		}()
+	}
+	if db == nil {
+		if q.DB == nil {
+			return errors.New("DB cannot be nil")
+		}
+		db = q.DB
+	}
+	if q.RowMapper == nil {
+		return fmt.Errorf("cannot call Fetch/FetchContext without a mapper")
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			switch v := r.(type) {
+			case ExitCode:
+				if v != ExitPeacefully {
+					err = v
+				}
+			case error:
+				err = v
+			default:
+				err = fmt.Errorf("%#v", r)
+			}
+			return
+		}
+		if q.Log == nil {
+			return
+		}
+		elapsed := time.Since(start)
+		if Lresults&q.LogFlag != 0 && rowcount > 5 {
+			logBuf.WriteString("\n...")
+		}
+		if Lstats&q.LogFlag != 0 {
+			logBuf.WriteString("\n(Fetched ")
+			logBuf.WriteString(strconv.Itoa(rowcount))
+			logBuf.WriteString(" rows in ")
+			logBuf.WriteString(elapsed.String())
+			logBuf.WriteString(")")
+		}
+		if logBuf.Len() > 0 {
+			switch q.Log.(type) {
+			case *log.Logger:
+				_ = q.Log.Output(q.logSkip+2, logBuf.String())
+			default:
+				_ = q.Log.Output(q.logSkip+1, logBuf.String())
+			}
+		}
+	}()
+	r := &Row{}
+	q.RowMapper(r)
+	q.ReturningFields = r.fields
+	tmpbuf := &strings.Builder{}
+	var tmpargs []interface{}
+	q.logSkip += 1
+	q.AppendSQL(tmpbuf, &tmpargs, nil)
+	if q.LogFunc != nil {
+		logQuery = tmpbuf.String()
+		logArgs = append(logArgs, tmpargs...)
+	}
+	if ctx == nil {
+		r.rows, err = db.Query(tmpbuf.String(), tmpargs...)
+	} else {
+		r.rows, err = db.QueryContext(ctx, tmpbuf.String(), tmpargs...)
+	}
+	if err != nil {
+		return err
+	}
+	defer r.rows.Close()
+	if len(r.dest) == 0 {
+		return nil
+	}
+	for r.rows.Next() {
+		rowcount++
+		err = r.rows.Scan(r.dest...)
+		if err != nil {
+			errbuf := &strings.Builder{}
+			for i := range r.dest {
+				tmpbuf.Reset()
+				tmpargs = tmpargs[:0]
+				r.fields[i].AppendSQLExclude(tmpbuf, &tmpargs, nil, nil)
+				errbuf.WriteString("\n" +
+					strconv.Itoa(i) + ") " +
+					questionInterpolate(tmpbuf.String(), tmpargs...) + " => " +
+					reflect.TypeOf(r.dest[i]).String())
+			}
+			return fmt.Errorf("Please check if your mapper function is correct:%s\n%w", errbuf.String(), err)
+		}
+		if q.Log != nil && Lresults&q.LogFlag != 0 && rowcount <= 5 {
+			logBuf.WriteString("\n----[ Row ")
+			logBuf.WriteString(strconv.Itoa(rowcount))
+			logBuf.WriteString(" ]----")
+			for i := range r.dest {
+				tmpbuf.Reset()
+				tmpargs = tmpargs[:0]
+				r.fields[i].AppendSQLExclude(tmpbuf, &tmpargs, nil, nil)
+				logBuf.WriteString("\n")
+				logBuf.WriteString(questionInterpolate(tmpbuf.String(), tmpargs...))
+				logBuf.WriteString(": ")
+				logBuf.WriteString(appendSQLDisplay(r.dest[i]))
+			}
+		}
+		r.index = 0
+		q.RowMapper(r)
+		if q.Accumulator == nil {
+			break
+		}
+		q.Accumulator()
+	}
+	if rowcount == 0 && q.Accumulator == nil {
+		return sql.ErrNoRows
+	}
+	if e := r.rows.Close(); e != nil {
+		return e
+	}
+	return r.rows.Err()
+}
+
+// Exec will execute the InsertQuery with the given DB. It will only compute
+// the rowsAffected if the ErowsAffected Execflag is passed to it.
+func (q InsertQuery) Exec(db DB, flag ExecFlag) (rowsAffected int64, err error) {
+	q.logSkip += 1
+	return q.ExecContext(nil, db, flag)
+}
+
+// ExecContext will execute the InsertQuery with the given DB and context. It will
+// only compute the rowsAffected if the ErowsAffected Execflag is passed to it.
+func (q InsertQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (rowsAffected int64, err error) {
+	logBuf := &strings.Builder{}
+	start := time.Now()
+	var logQuery string
+	var logArgs []interface{}
+	if q.LogFunc != nil {
+		defer func() {
+			q.LogFunc(LogInfo{
+				LogFlag:      q.LogFlag,
+				LogSkip:      q.logSkip + 3,
+				Query:        logQuery,
+				Args:         logArgs,
+				Action:       LogActionExec,
+				TimeTaken:    time.Since(start),
+				Err:          err,
+				ExecFlag:     flag,
+				RowsAffected: rowsAffected,
+			})
+		}()
+	}
+	if db == nil {
+		if q.DB == nil {
+			return rowsAffected, errors.New("DB cannot be nil")
+		}
+		db = q.DB
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			switch v := r.(type) {
+			case error:
+				err = v
+			default:
+				err = fmt.Errorf("%#v", r)
+			}
+			return
+		}
+		if q.Log == nil {
+			return
+		}
+		elapsed := time.Since(start)
+		if Lstats&q.LogFlag != 0 && ErowsAffected&flag != 0 {
+			logBuf.WriteString("\n(Inserted ")
+			logBuf.WriteString(strconv.FormatInt(rowsAffected, 10))
+			logBuf.WriteString(" rows in ")
+			logBuf.WriteString(elapsed.String())
+			logBuf.WriteString(")")
+		}
+		if logBuf.Len() > 0 {
+			switch q.Log.(type) {
+			case *log.Logger:
+				_ = q.Log.Output(q.logSkip+2, logBuf.String())
+			default:
+				_ = q.Log.Output(q.logSkip+1, logBuf.String())
+			}
+		}
+	}()
+	var res sql.Result
+	tmpbuf := &strings.Builder{}
+	var tmpargs []interface{}
+	q.logSkip += 1
+	q.AppendSQL(tmpbuf, &tmpargs, nil)
+	if q.LogFunc != nil {
+		logQuery, logArgs = tmpbuf.String(), tmpargs
+	}
+	if ctx == nil {
+		res, err = db.Exec(tmpbuf.String(), tmpargs...)
+	} else {
+		res, err = db.ExecContext(ctx, tmpbuf.String(), tmpargs...)
+	}
+	if err != nil {
+		return rowsAffected, err
+	}
+	if res != nil && ErowsAffected&flag != 0 {
+		rowsAffected, err = res.RowsAffected()
+		if err != nil {
+			return rowsAffected, err
+		}
+	}
+	return rowsAffected, nil
+}
+
+// batches splits the InsertQuery into InsertQueries of at most batchSize rows
+// each. An InsertQuery without VALUES (e.g. INSERT ... SELECT) is not split.
+func (q InsertQuery) batches(batchSize int) (batches []InsertQuery, err error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("batchSize must be positive, got %d", batchSize)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			switch v := r.(type) {
+			case error:
+				err = v
+			default:
+				err = fmt.Errorf("%#v", r)
+			}
+		}
+	}()
+	if q.ColumnMapper != nil {
+		col := &Column{mode: colmodeInsert}
+		q.ColumnMapper(col)
+		q.InsertColumns = col.insertColumns
+		q.RowValues = col.rowValues
+		q.ColumnMapper = nil
+	}
+	if len(q.RowValues) <= batchSize {
+		return []InsertQuery{q}, nil
+	}
+	rowValues := q.RowValues
+	for len(rowValues) > 0 {
+		n := batchSize
+		if n > len(rowValues) {
+			n = len(rowValues)
+		}
+		q.RowValues = rowValues[:n:n]
+		batches = append(batches, q)
+		rowValues = rowValues[n:]
+	}
+	return batches, nil
+}
+
+// FetchBatched is like Fetch, but splits the rows of the InsertQuery into
+// several INSERT statements of at most batchSize rows each (see ExecBatched).
+// With an accumulator, the mapper and accumulator are run for the rows
+// returned by every statement. Without one, the mapper is only run for the
+// first row that is returned, the same as Fetch, and the rest of the
+// statements are only executed. Call FetchBatched inside Tx to run all of the
+// statements in one transaction.
+func (q InsertQuery) FetchBatched(db DB, batchSize int) (err error) {
+	q.logSkip += 1
+	return q.FetchBatchedContext(nil, db, batchSize)
+}
+
+// FetchBatchedContext is like FetchBatched, but runs every statement with the
+// given context.
+func (q InsertQuery) FetchBatchedContext(ctx context.Context, db DB, batchSize int) (err error) {
+	batches, err := q.batches(batchSize)
+	if err != nil {
+		return err
+	}
+	var fetched bool
+	for _, batch := range batches {
+		batch.logSkip += 1
+		if fetched && q.Accumulator == nil {
+			// the mapper already has the first row, so running it again would
+			// overwrite that row with the first row of this batch
+			_, err = batch.ExecContext(ctx, db, 0)
+		} else {
+			err = batch.FetchContext(ctx, db)
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		fetched = true
+	}
+	if !fetched && q.Accumulator == nil {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// ExecBatched is like Exec, but splits the rows of the InsertQuery into
+// several INSERT statements of at most batchSize rows each, which keeps large
+// inserts under the SQLite limit of 32766 bind parameters per statement.
+// The rowsAffected of every statement are added together. If the Etransaction
+// ExecFlag is passed, all of the statements are run inside one transaction
+// (or a savepoint, if db is already a transaction).
+func (q InsertQuery) ExecBatched(db DB, batchSize int, flag ExecFlag) (rowsAffected int64, err error) {
+	q.logSkip += 1
+	return q.ExecBatchedContext(nil, db, batchSize, flag)
+}
+
+// ExecBatchedContext is like ExecBatched, but runs every statement with the
+// given context.
+func (q InsertQuery) ExecBatchedContext(ctx context.Context, db DB, batchSize int, flag ExecFlag) (rowsAffected int64, err error) {
+	if db == nil {
+		if q.DB == nil {
+			return rowsAffected, errors.New("DB cannot be nil")
+		}
+		db = q.DB
+	}
+	batches, err := q.batches(batchSize)
+	if err != nil {
+		return rowsAffected, err
+	}
+	logSkip := 2
+	execBatches := func(db DB) error {
+		rowsAffected = 0
+		for _, batch := range batches {
+			batch.logSkip += logSkip
+			n, err := batch.ExecContext(ctx, db, flag)
+			rowsAffected += n
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if Etransaction&flag == 0 {
+		err = execBatches(db)
+		return rowsAffected, err
+	}
+	logSkip += 2
+	err = Tx(ctx, db, nil, execBatches)
+	return rowsAffected, err
+}
+
+// NestThis indicates to the InsertQuery that it is nested.
+func (q InsertQuery) NestThis() Query {
+	q.nested = true
+	return q
+}
@@ -0,0 +1,191 @@
+package sq
+
+import "strings"
+
+// JoinType represents the various types of SQL joins.
+type JoinType string
+
+// JoinTypes
+const (
+	JoinTypeInner JoinType = "JOIN"
+	JoinTypeLeft  JoinType = "LEFT JOIN"
+	JoinTypeRight JoinType = "RIGHT JOIN"
+	JoinTypeFull  JoinType = "FULL JOIN"
+)
+
+// JoinTable represents an SQL join.
+type JoinTable struct {
+	JoinType     JoinType
+	Table        Table
+	OnPredicates VariadicPredicate
+}
+
+// Join creates a new inner join.
+func Join(table Table, predicates ...Predicate) JoinTable {
+	return JoinTable{
+		JoinType: JoinTypeInner,
+		Table:    table,
+		OnPredicates: VariadicPredicate{
+			Predicates: predicates,
+		},
+	}
+}
+
+// LeftJoin creates a new left join.
+func LeftJoin(table Table, predicates ...Predicate) JoinTable {
+	return JoinTable{
+		JoinType: JoinTypeLeft,
+		Table:    table,
+		OnPredicates: VariadicPredicate{
+			Predicates: predicates,
+		},
+	}
+}
+
+// RightJoin creates a new right join.
+func RightJoin(table Table, predicates ...Predicate) JoinTable {
+	return JoinTable{
+		JoinType: JoinTypeRight,
+		Table:    table,
+		OnPredicates: VariadicPredicate{
+			Predicates: predicates,
+		},
+	}
+}
+
+// FullJoin creates a new full join.
+func FullJoin(table Table, predicates ...Predicate) JoinTable {
+	return JoinTable{
+		JoinType: JoinTypeFull,
+		Table:    table,
+		OnPredicates: VariadicPredicate{
+			Predicates: predicates,
+		},
+	}
+}
+
+// CustomJoin constructs a new JoinTable. Meant to be used if you want to do a custom
+// join like CROSS JOIN, NATURAL JOIN, LEFT JOIN LATERAL etc.
+func CustomJoin(joinType JoinType, table Table, predicates ...Predicate) JoinTable {
+	return JoinTable{
+		JoinType: joinType,
+		Table:    table,
+		OnPredicates: VariadicPredicate{
+			Predicates: predicates,
+		},
+	}
+}
+
+// AppendSQL marshals the JoinTable into a buffer and an args slice.
+func (join JoinTable) AppendSQL(buf *strings.Builder, args *[]interface{}, params map[string]int) {
+	if join.JoinType == "" {
+		join.JoinType = JoinTypeInner
+	}
+	buf.WriteString(string(join.JoinType) + " ")
+	switch v := join.Table.(type) {
+	case nil:
+		buf.WriteString("NULL")
+	case Query:
+		buf.WriteString("(")
+		v.NestThis().AppendSQL(buf, args, nil)
+		buf.WriteString(")")
+	default:
+		join.Table.AppendSQL(buf, args, nil)
+	}
+	if join.Table != nil {
+		alias := join.Table.GetAlias()
+		if alias != "" {
+			buf.WriteString(" AS ")
+			buf.WriteString(alias)
+		}
+	}
+	if len(join.OnPredicates.Predicates) > 0 {
+		buf.WriteString(" ON ")
+		join.OnPredicates.toplevel = true
+		join.OnPredicates.AppendSQLExclude(buf, args, nil, nil)
+	}
+}
+
+// JoinTables is a list of JoinTables.
+type JoinTables []JoinTable
+
+// AppendSQL will write the JOIN clause into the buffer and args. If there are
+// no JoinTables it simply writes nothing into the buffer. It returns a flag
+// indicating whether anything was written into the buffer.
+func (joins JoinTables) AppendSQL(buf *strings.Builder, args *[]interface{}, params map[string]int) {
+	for i, join := range joins {
+		if i > 0 {
+			buf.WriteString(" ")
+		}
+		join.AppendSQL(buf, args, nil)
+	}
+}
+
+// JoinedTables is a FROM table together with the tables joined onto it. It is
+// meant to be embedded in a struct alongside the tables that it joins, so that
+// the same set of JOINs can be reused across queries with Fromx.
+type JoinedTables struct {
+	FromTable  Table
+	JoinTables JoinTables
+}
+
+// JoinedTablesGetter is anything that can return a JoinedTables. Any struct
+// that embeds JoinedTables satisfies this interface.
+type JoinedTablesGetter interface {
+	GetJoinedTables() JoinedTables
+}
+
+// JoinedFrom creates a new JoinedTables with table as the FROM table.
+func JoinedFrom(table Table) JoinedTables {
+	return JoinedTables{
+		FromTable: table,
+	}
+}
+
+// GetJoinedTables implements the JoinedTablesGetter interface.
+func (jt JoinedTables) GetJoinedTables() JoinedTables {
+	return jt
+}
+
+// appendJoin returns a new JoinedTables with the join appended. The
+// JoinTables are always copied so that appending to a shared JoinedTables
+// never affects the other queries using it.
+func (jt JoinedTables) appendJoin(join JoinTable) JoinedTables {
+	jt.JoinTables = append(jt.JoinTables[:len(jt.JoinTables):len(jt.JoinTables)], join)
+	return jt
+}
+
+// Join joins a new table to the JoinedTables based on the predicates.
+func (jt JoinedTables) Join(table Table, predicate Predicate, predicates ...Predicate) JoinedTables {
+	predicates = append([]Predicate{predicate}, predicates...)
+	return jt.appendJoin(Join(table, predicates...))
+}
+
+// LeftJoin left joins a new table to the JoinedTables based on the predicates.
+func (jt JoinedTables) LeftJoin(table Table, predicate Predicate, predicates ...Predicate) JoinedTables {
+	predicates = append([]Predicate{predicate}, predicates...)
+	return jt.appendJoin(LeftJoin(table, predicates...))
+}
+
+// RightJoin right joins a new table to the JoinedTables based on the predicates.
+func (jt JoinedTables) RightJoin(table Table, predicate Predicate, predicates ...Predicate) JoinedTables {
+	predicates = append([]Predicate{predicate}, predicates...)
+	return jt.appendJoin(RightJoin(table, predicates...))
+}
+
+// FullJoin full joins a table to the JoinedTables based on the predicates.
+func (jt JoinedTables) FullJoin(table Table, predicate Predicate, predicates ...Predicate) JoinedTables {
+	predicates = append([]Predicate{predicate}, predicates...)
+	return jt.appendJoin(FullJoin(table, predicates...))
+}
+
+// CustomJoin custom joins a table to the JoinedTables. The join type can be
+// specified with a string, e.g. "CROSS JOIN".
+func (jt JoinedTables) CustomJoin(joinType JoinType, table Table, predicates ...Predicate) JoinedTables {
+	return jt.appendJoin(CustomJoin(joinType, table, predicates...))
+}
+
+// prependJoins returns the JoinedTables' JoinTables followed by the joins.
+func (jt JoinedTables) prependJoins(joins JoinTables) JoinTables {
+	return append(jt.JoinTables[:len(jt.JoinTables):len(jt.JoinTables)], joins...)
+}
@@ -0,0 +1,207 @@
+package sq
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestJoinTable_AppendSQL(t *testing.T) {
+	type TT struct {
+		description string
+		j           JoinTable
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	tests := []TT{
+		func() TT {
+			desc := "empty"
+			j := CustomJoin("", nil)
+			wantQuery := "JOIN NULL"
+			return TT{desc, j, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "join table"
+			u := USERS()
+			j := CustomJoin(JoinTypeLeft, u, u.USER_ID.EqInt(1), u.DISPLAYNAME.EqString("John"))
+			wantQuery := "LEFT JOIN main.users ON users.user_id = ? AND users.displayname = ?"
+			wantArgs := []interface{}{1, "John"}
+			return TT{desc, j, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "custom join table with alias"
+			u := USERS().As("u")
+			j := CustomJoin("LEFT JOIN LATERAL", u, u.USER_ID.EqInt(1), u.DISPLAYNAME.EqString("John"))
+			wantQuery := "LEFT JOIN LATERAL main.users AS u ON u.user_id = ? AND u.displayname = ?"
+			wantArgs := []interface{}{1, "John"}
+			return TT{desc, j, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "join query"
+			u := USERS().As("u")
+			q := Select(u.USER_ID, u.DISPLAYNAME, u.EMAIL).From(u).Subquery("subquery")
+			j := CustomJoin(JoinTypeInner, q, q["user_id"].Eq(1), q["displayname"].Eq("John"))
+			wantQuery := "JOIN (" +
+				"SELECT u.user_id, u.displayname, u.email FROM main.users AS u" +
+				") AS subquery ON subquery.user_id = ? AND subquery.displayname = ?"
+			wantArgs := []interface{}{1, "John"}
+			return TT{desc, j, wantQuery, wantArgs}
+		}(),
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.j.AppendSQL(buf, &args, nil)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
+
+func TestJoinTables_AppendSQL(t *testing.T) {
+	type TT struct {
+		description string
+		j           JoinTables
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	tests := []TT{
+		func() TT {
+			desc := "empty"
+			j := JoinTables{}
+			wantQuery := ""
+			return TT{desc, j, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "basic"
+			u := USERS().As("u")
+			j := JoinTables{
+				CustomJoin(JoinTypeLeft, u, u.USER_ID.EqInt(1), u.DISPLAYNAME.EqString("John")),
+				CustomJoin(JoinTypeRight, u, u.DISPLAYNAME.EqString("Jane"), u.USER_ID.EqInt(2)),
+				CustomJoin(JoinTypeFull, u),
+			}
+			wantQuery := "LEFT JOIN main.users AS u ON u.user_id = ? AND u.displayname = ?" +
+				" RIGHT JOIN main.users AS u ON u.displayname = ? AND u.user_id = ?" +
+				" FULL JOIN main.users AS u"
+			wantArgs := []interface{}{1, "John", "Jane", 2}
+			return TT{desc, j, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "more joins"
+			u := USERS().As("u")
+			j := JoinTables{
+				Join(u, u.USER_ID.EqInt(1), u.DISPLAYNAME.EqString("John")),
+				LeftJoin(u, u.DISPLAYNAME.EqString("Jane"), u.USER_ID.EqInt(2)),
+				RightJoin(u, u.DISPLAYNAME.EqString("Street"), u.USER_ID.EqInt(3)),
+				FullJoin(u),
+			}
+			wantQuery := "JOIN main.users AS u ON u.user_id = ? AND u.displayname = ?" +
+				" LEFT JOIN main.users AS u ON u.displayname = ? AND u.user_id = ?" +
+				" RIGHT JOIN main.users AS u ON u.displayname = ? AND u.user_id = ?" +
+				" FULL JOIN main.users AS u"
+			wantArgs := []interface{}{1, "John", "Jane", 2, "Street", 3}
+			return TT{desc, j, wantQuery, wantArgs}
+		}(),
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.j.AppendSQL(buf, &args, nil)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
+
+func TestJoinTable_Basic(t *testing.T) {
+}
+
+type JOIN_USERS_ALL struct {
+	JoinedTables
+	U   TABLE_USERS
+	UR  TABLE_USER_ROLES
+	URS TABLE_USER_ROLES_STUDENTS
+}
+
+func USERS_ALL() JOIN_USERS_ALL {
+	join := JOIN_USERS_ALL{}
+	join.U = USERS().As("u")
+	join.UR = USER_ROLES().As("ur")
+	join.URS = USER_ROLES_STUDENTS().As("urs")
+	join.JoinedTables = JoinedFrom(join.U).
+		Join(join.UR, join.UR.USER_ID.Eq(join.U.USER_ID)).
+		LeftJoin(join.URS, join.URS.USER_ROLE_ID.Eq(join.UR.USER_ROLE_ID))
+	return join
+}
+
+func TestJoinedTables(t *testing.T) {
+	type TT struct {
+		description string
+		q           Query
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	ua := USERS_ALL()
+	t1 := TEAMS().As("t1")
+	t2 := TEAMS().As("t2")
+	// joins appended onto a shared JoinedTables must not affect each other,
+	// even if its JoinTables has spare capacity
+	base := JoinedFrom(ua.U)
+	base.JoinTables = make(JoinTables, 0, 10)
+	base = base.Join(ua.UR, ua.UR.USER_ID.Eq(ua.U.USER_ID))
+	jt1 := base.CustomJoin("CROSS JOIN", t1)
+	jt2 := base.CustomJoin("CROSS JOIN", t2)
+	tests := []TT{
+		{
+			"Fromx",
+			Fromx(ua).Where(ua.URS.TEAM_ID.EqInt(15)).Select(ua.U.USER_ID, ua.URS.TEAM_ID),
+			"SELECT u.user_id, urs.team_id FROM main.users AS u JOIN main.user_roles AS ur ON ur.user_id = u.user_id LEFT JOIN main.user_roles_students AS urs ON urs.user_role_id = ur.user_role_id WHERE urs.team_id = ?",
+			[]interface{}{15},
+		},
+		{
+			"Fromx with further joins",
+			WithDB(nil).Fromx(ua).Join(t1, t1.TEAM_ID.Eq(ua.URS.TEAM_ID)).Select(t1.TEAM_ID),
+			"SELECT t1.team_id FROM main.users AS u JOIN main.user_roles AS ur ON ur.user_id = u.user_id LEFT JOIN main.user_roles_students AS urs ON urs.user_role_id = ur.user_role_id JOIN main.teams AS t1 ON t1.team_id = urs.team_id",
+			nil,
+		},
+		{
+			"shared JoinedTables 1",
+			Fromx(jt1).SelectOne(),
+			"SELECT 1 FROM main.users AS u JOIN main.user_roles AS ur ON ur.user_id = u.user_id CROSS JOIN main.teams AS t1",
+			nil,
+		},
+		{
+			"shared JoinedTables 2",
+			Fromx(jt2).SelectOne(),
+			"SELECT 1 FROM main.users AS u JOIN main.user_roles AS ur ON ur.user_id = u.user_id CROSS JOIN main.teams AS t2",
+			nil,
+		},
+		{
+			"UpdateQuery Fromx",
+			Update(t1).Set(t1.TEAM_NAME.SetString("x")).Fromx(ua).Where(t1.TEAM_ID.Eq(ua.URS.TEAM_ID)),
+			"UPDATE main.teams AS t1 SET team_name = ? FROM main.users AS u JOIN main.user_roles AS ur ON ur.user_id = u.user_id LEFT JOIN main.user_roles_students AS urs ON urs.user_role_id = ur.user_role_id WHERE t1.team_id = urs.team_id",
+			[]interface{}{"x"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.q.AppendSQL(buf, &args, nil)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
@@ -0,0 +1,442 @@
+package sq
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestNumberField_AppendSQLExclude(t *testing.T) {
+	type TT struct {
+		description string
+		f           NumberField
+		exclude     []string
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	tests := []TT{
+		func() TT {
+			desc := "literal int"
+			f := Int(1)
+			wantQuery := "?"
+			wantArgs := []interface{}{1}
+			return TT{desc, f, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "literal int64"
+			f := Int64(1)
+			wantQuery := "?"
+			wantArgs := []interface{}{int64(1)}
+			return TT{desc, f, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "literal float64"
+			f := Float64(33.27)
+			wantQuery := "?"
+			wantArgs := []interface{}{33.27}
+			return TT{desc, f, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "table qualified"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			wantQuery := "users.user_id"
+			return TT{desc, f, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "table alias qualified"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users", Alias: "u"})
+			wantQuery := "u.user_id"
+			return TT{desc, f, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "excludedTableQualifiers (name)"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			exclude := []string{"users"}
+			wantQuery := "user_id"
+			return TT{desc, f, exclude, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "excludedTableQualifiers (alias)"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users", Alias: "u"})
+			exclude := []string{"u"}
+			wantQuery := "user_id"
+			return TT{desc, f, exclude, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "quoted whitespace"
+			f := NewNumberField("zip code", &TableInfo{Schema: "public", Name: "registered users"})
+			wantQuery := `"registered users"."zip code"`
+			return TT{desc, f, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "ASC"
+			f := NewNumberField("zip code", &TableInfo{Schema: "public", Name: "registered users"}).Asc()
+			wantQuery := `"registered users"."zip code" ASC`
+			return TT{desc, f, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "DESC"
+			f := NewNumberField("zip code", &TableInfo{Schema: "public", Name: "registered users"}).Desc()
+			wantQuery := `"registered users"."zip code" DESC`
+			return TT{desc, f, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "NULLS FIRST"
+			f := NewNumberField("zip code", &TableInfo{Schema: "public", Name: "registered users"}).NullsFirst()
+			wantQuery := `"registered users"."zip code" NULLS FIRST`
+			return TT{desc, f, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "NULLS LAST"
+			f := NewNumberField("zip code", &TableInfo{Schema: "public", Name: "registered users"}).NullsLast()
+			wantQuery := `"registered users"."zip code" NULLS LAST`
+			return TT{desc, f, nil, wantQuery, nil}
+		}(),
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			var _ Field = tt.f
+			tt.f.AppendSQLExclude(buf, &args, nil, tt.exclude)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
+
+func TestNumberField_FieldAssignment(t *testing.T) {
+	type TT struct {
+		description string
+		a           FieldAssignment
+		exclude     []string
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+	tests := []TT{
+		{
+			"set field",
+			f.Set(f),
+			nil,
+			"users.user_id = users.user_id",
+			nil,
+		},
+		{
+			"set int",
+			f.Set(1),
+			nil,
+			"users.user_id = ?",
+			[]interface{}{1},
+		},
+		{
+			"setint int",
+			f.SetInt(1),
+			nil,
+			"users.user_id = ?",
+			[]interface{}{1},
+		},
+		{
+			"setint64 int64",
+			f.SetInt64(1),
+			nil,
+			"users.user_id = ?",
+			[]interface{}{int64(1)},
+		},
+		{
+			"setfloat64 float64",
+			f.SetFloat64(33.27),
+			nil,
+			"users.user_id = ?",
+			[]interface{}{33.27},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.a.AppendSQLExclude(buf, &args, nil, tt.exclude)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
+
+func TestNumberField_Predicates(t *testing.T) {
+	type TT struct {
+		description string
+		p           Predicate
+		exclude     []string
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	tests := []TT{
+		func() TT {
+			desc := "IsNull"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.IsNull()
+			wantQuery := "users.user_id IS NULL"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "IsNotNull"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.IsNotNull()
+			wantQuery := "users.user_id IS NOT NULL"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "Eq"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.Eq(f)
+			wantQuery := "users.user_id = users.user_id"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "Ne"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.Ne(f)
+			wantQuery := "users.user_id <> users.user_id"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "Gt"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.Gt(f)
+			wantQuery := "users.user_id > users.user_id"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "Ge"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.Ge(f)
+			wantQuery := "users.user_id >= users.user_id"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "Lt"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.Lt(f)
+			wantQuery := "users.user_id < users.user_id"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "Le"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.Le(f)
+			wantQuery := "users.user_id <= users.user_id"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "EqInt"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.EqInt(1)
+			wantQuery := "users.user_id = ?"
+			wantArgs := []interface{}{1}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "NeInt"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.NeInt(1)
+			wantQuery := "users.user_id <> ?"
+			wantArgs := []interface{}{1}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "GtInt"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.GtInt(1)
+			wantQuery := "users.user_id > ?"
+			wantArgs := []interface{}{1}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "GeInt"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.GeInt(1)
+			wantQuery := "users.user_id >= ?"
+			wantArgs := []interface{}{1}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "LtInt"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.LtInt(1)
+			wantQuery := "users.user_id < ?"
+			wantArgs := []interface{}{1}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "LeInt"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.LeInt(1)
+			wantQuery := "users.user_id <= ?"
+			wantArgs := []interface{}{1}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "EqFloat64"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.EqFloat64(33.27)
+			wantQuery := "users.user_id = ?"
+			wantArgs := []interface{}{33.27}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "NeFloat64"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.NeFloat64(33.27)
+			wantQuery := "users.user_id <> ?"
+			wantArgs := []interface{}{33.27}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "GtFloat64"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.GtFloat64(33.27)
+			wantQuery := "users.user_id > ?"
+			wantArgs := []interface{}{33.27}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "GeFloat64"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.GeFloat64(33.27)
+			wantQuery := "users.user_id >= ?"
+			wantArgs := []interface{}{33.27}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "LtFloat64"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.LtFloat64(33.27)
+			wantQuery := "users.user_id < ?"
+			wantArgs := []interface{}{33.27}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "LeFloat64"
+			f := NewNumberField("user_id", &TableInfo{Schema: "public", Name: "users"})
+			p := f.LeFloat64(33.27)
+			wantQuery := "users.user_id <= ?"
+			wantArgs := []interface{}{33.27}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "In slice"
+			f := Fieldf("users.user_id")
+			p := f.In([]int{1, 2, 3})
+			wantQuery := "users.user_id IN (?, ?, ?)"
+			wantArgs := []interface{}{1, 2, 3}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "In Fields"
+			f := Fieldf("users.user_id")
+			p := f.In(Fields{f, f, f})
+			wantQuery := "users.user_id IN (users.user_id, users.user_id, users.user_id)"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.p.AppendSQLExclude(buf, &args, nil, tt.exclude)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
+
+func TestNumberField_Arithmetic(t *testing.T) {
+	type TT struct {
+		description string
+		f           NumberField
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	tbl := &TableInfo{Name: "orders"}
+	price := NewNumberField("price", tbl)
+	quantity := NewNumberField("quantity", tbl)
+	discount := NewNumberField("discount", tbl)
+	tests := []TT{
+		{"Mul", price.Mul(quantity), "orders.price * orders.quantity", nil},
+		{"Add Go number", price.Add(5), "orders.price + ?", []interface{}{5}},
+		{"Sub literal", price.Sub(Float64(0.5)), "orders.price - ?", []interface{}{0.5}},
+		{"additive inside multiplicative", price.Sub(discount).Mul(quantity), "(orders.price - orders.discount) * orders.quantity", nil},
+		{"multiplicative inside additive", price.Mul(quantity).Sub(discount), "orders.price * orders.quantity - orders.discount", nil},
+		{"right operand of the same precedence", price.Sub(quantity.Sub(discount)), "orders.price - (orders.quantity - orders.discount)", nil},
+		{"left operand of the same precedence", price.Sub(quantity).Sub(discount), "orders.price - orders.quantity - orders.discount", nil},
+		{"Div Mod", price.Div(quantity.Mod(2)), "orders.price / (orders.quantity % ?)", []interface{}{2}},
+		{"Neg column", price.Neg(), "-orders.price", nil},
+		{"Neg expression", price.Add(discount).Neg().Mul(2), "-(orders.price + orders.discount) * ?", []interface{}{2}},
+		{"Neg literal", Int(-5).Neg(), "-(?)", []interface{}{-5}},
+		{"NumberFieldf operand", NumberFieldf("? + ?", price, 1).Mul(2), "(orders.price + ?) * ?", []interface{}{1, 2}},
+		{"Abs", price.Sub(discount).Abs(), "ABS(orders.price - orders.discount)", nil},
+		{"Round", price.Mul(quantity).Round(2), "ROUND(orders.price * orders.quantity, 2)", nil},
+		{"Round 0 places", price.Round(0), "ROUND(orders.price)", nil},
+		{"Ceil Floor", price.Ceil().Sub(price.Floor()), "CEIL(orders.price) - FLOOR(orders.price)", nil},
+		{"Power", price.Power(quantity.Add(1)), "POWER(orders.price, orders.quantity + ?)", []interface{}{1}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.f.AppendSQLExclude(buf, &args, nil, nil)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+
+	t.Run("SetExpr", func(t *testing.T) {
+		is := is.New(t)
+		buf := &strings.Builder{}
+		var args []interface{}
+		price.SetExpr(price.Mul(1.1).Round(2)).AppendSQLExclude(buf, &args, nil, nil)
+		is.Equal("orders.price = ROUND(orders.price * ?, 2)", buf.String())
+		is.Equal([]interface{}{1.1}, args)
+	})
+
+	t.Run("Fetch", func(t *testing.T) {
+		if testing.Short() {
+			return
+		}
+		is := is.New(t)
+		db, err := sql.Open("devlab", "NumberField_Arithmetic")
+		is.NoErr(err)
+		defer db.Close()
+		var sub, mul, neg, mod int
+		var round, power float64
+		err = SelectRowx(func(row *Row) {
+			sub = row.Int(Int(10).Sub(Int(4).Sub(1)))
+			mul = row.Int(Int(10).Sub(4).Mul(2))
+			neg = row.Int(Int(3).Add(4).Neg())
+			mod = row.Int(Int(17).Mod(5))
+			round = row.Float64(Float64(2).Div(3).Round(2))
+			power = row.Float64(Int(2).Power(10))
+		}).Fetch(db)
+		is.NoErr(err)
+		is.Equal(7, sub)
+		is.Equal(12, mul)
+		is.Equal(-7, neg)
+		is.Equal(2, mod)
+		is.Equal(0.67, round)
+		is.Equal(1024.0, power)
+	})
+}
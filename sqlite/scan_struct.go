@@ -0,0 +1,136 @@
+package sq
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// structColumn maps a struct field to a column of a table struct.
+type structColumn struct {
+	fieldIndex  []int
+	columnIndex []int
+}
+
+// structMappings caches the []structColumn for every pair of struct type and
+// table type passed to ScanStruct.
+var structMappings sync.Map
+
+// structMapping returns how the fields of structType map to the columns of the
+// table. Struct fields are matched by their `sq:"column_name"` tag, fields
+// without a tag (or tagged with `sq:"-"`) are skipped.
+func structMapping(structType reflect.Type, tableValue reflect.Value) ([]structColumn, error) {
+	key := [2]reflect.Type{structType, tableValue.Type()}
+	if mapping, ok := structMappings.Load(key); ok {
+		return mapping.([]structColumn), nil
+	}
+	columns := make(map[string][]int)
+	for _, f := range reflect.VisibleFields(tableValue.Type()) {
+		if f.Anonymous || !f.IsExported() || len(f.Index) > 1 {
+			continue
+		}
+		field, ok := tableValue.FieldByIndex(f.Index).Interface().(Field)
+		if !ok {
+			continue
+		}
+		columns[field.GetName()] = f.Index
+	}
+	var mapping []structColumn
+	for _, f := range reflect.VisibleFields(structType) {
+		name, _, _ := strings.Cut(f.Tag.Get("sq"), ",")
+		if name == "" || name == "-" || f.Anonymous || !f.IsExported() || embedsPointer(structType, f.Index) {
+			continue
+		}
+		columnIndex, ok := columns[name]
+		if !ok {
+			return nil, fmt.Errorf("%s.%s: table %s has no column %q", structType, f.Name, tableValue.Type(), name)
+		}
+		mapping = append(mapping, structColumn{fieldIndex: f.Index, columnIndex: columnIndex})
+	}
+	structMappings.Store(key, mapping)
+	return mapping, nil
+}
+
+// embedsPointer reports whether the field at index is promoted through an
+// embedded pointer, which may be nil.
+func embedsPointer(t reflect.Type, index []int) bool {
+	for _, i := range index[:len(index)-1] {
+		t = t.Field(i).Type
+		if t.Kind() == reflect.Ptr {
+			return true
+		}
+	}
+	return false
+}
+
+// ScanStruct scans the columns of the table into the fields of dest, where
+// dest is a pointer to a struct. Each struct field tagged `sq:"column_name"`
+// is scanned from the table column with that name, the same way as ScanInto.
+// The table must be a table struct like the ones generated by sqgen.
+//
+//	type User struct {
+//		UserID int    `sq:"user_id"`
+//		Name   string `sq:"name"`
+//	}
+//	var user User
+//	row.ScanStruct(&user, u)
+func (r *Row) ScanStruct(dest interface{}, table Table) {
+	destValue := reflect.ValueOf(dest)
+	if destValue.Kind() != reflect.Ptr || destValue.IsNil() || destValue.Elem().Kind() != reflect.Struct {
+		panic(fmt.Errorf("cannot pass in non struct pointer value (%#v) as dest", dest))
+	}
+	tableValue := reflect.ValueOf(table)
+	if tableValue.Kind() == reflect.Ptr && !tableValue.IsNil() {
+		tableValue = tableValue.Elem()
+	}
+	if tableValue.Kind() != reflect.Struct {
+		panic(fmt.Errorf("cannot pass in non struct value (%#v) as table", table))
+	}
+	mapping, err := structMapping(destValue.Elem().Type(), tableValue)
+	if err != nil {
+		panic(err)
+	}
+	structValue := destValue.Elem()
+	for _, m := range mapping {
+		field := tableValue.FieldByIndex(m.columnIndex).Interface().(Field)
+		r.ScanInto(structValue.FieldByIndex(m.fieldIndex).Addr().Interface(), field)
+	}
+}
+
+// SelectStruct sets the mapper function (and accumulator function) of the
+// SelectQuery to scan the FROM table into dest using ScanStruct, so From must
+// be called before SelectStruct. The dest may be a pointer to a struct, which
+// will hold the first row, or a pointer to a slice of structs (or struct
+// pointers) to which every row is appended.
+func (q SelectQuery) SelectStruct(dest interface{}) SelectQuery {
+	table := q.FromTable
+	destValue := reflect.ValueOf(dest)
+	if destValue.Kind() != reflect.Ptr || destValue.IsNil() || destValue.Elem().Kind() != reflect.Slice {
+		q.RowMapper = func(row *Row) {
+			row.ScanStruct(dest, table)
+		}
+		q.Accumulator = nil
+		return q
+	}
+	sliceValue := destValue.Elem()
+	elemType := sliceValue.Type().Elem()
+	structType := elemType
+	if elemType.Kind() == reflect.Ptr {
+		structType = elemType.Elem()
+	}
+	item := reflect.New(structType)
+	q.RowMapper = func(row *Row) {
+		row.ScanStruct(item.Interface(), table)
+	}
+	q.Accumulator = func() {
+		if elemType.Kind() == reflect.Ptr {
+			elem := reflect.New(structType)
+			elem.Elem().Set(item.Elem())
+			sliceValue.Set(reflect.Append(sliceValue, elem))
+		} else {
+			sliceValue.Set(reflect.Append(sliceValue, item.Elem()))
+		}
+	}
+	return q
+}
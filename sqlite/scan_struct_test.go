@@ -0,0 +1,118 @@
+package sq
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+type taggedUser struct {
+	UserID      int            `sq:"user_id"`
+	Displayname string         `sq:"displayname"`
+	Email       sql.NullString `sq:"email"`
+	Ignored     string
+	Skipped     string `sq:"-"`
+}
+
+func TestRow_ScanStruct(t *testing.T) {
+	is := is.New(t)
+	u := USERS().As("u")
+
+	// Fields are resolved from the tags
+	row := &Row{}
+	var user taggedUser
+	row.ScanStruct(&user, u)
+	is.Equal(Fields{u.USER_ID, u.DISPLAYNAME, u.EMAIL}, Fields(row.fields))
+
+	// Embedded structs
+	type embeddedUser struct {
+		taggedUser
+		Password string `sq:"password"`
+	}
+	row = &Row{}
+	var embedded embeddedUser
+	row.ScanStruct(&embedded, u)
+	is.Equal(Fields{u.USER_ID, u.DISPLAYNAME, u.EMAIL, u.PASSWORD}, Fields(row.fields))
+
+	// Unknown column
+	func() {
+		defer func() { is.True(recover() != nil) }()
+		var v struct {
+			Name string `sq:"name"`
+		}
+		(&Row{}).ScanStruct(&v, u)
+	}()
+
+	// Non struct pointer
+	func() {
+		defer func() { is.True(recover() != nil) }()
+		(&Row{}).ScanStruct(user, u)
+	}()
+}
+
+func TestSelectQuery_SelectStruct(t *testing.T) {
+	is := is.New(t)
+	u := USERS()
+
+	// Bad dest
+	var notStruct int
+	err := WithDB(&sql.DB{}).From(u).SelectStruct(&notStruct).Fetch(nil)
+	is.True(err != nil)
+
+	if testing.Short() {
+		return
+	}
+	db, err := sql.Open("devlab", "SelectQuery_SelectStruct")
+	is.NoErr(err)
+	defer db.Close()
+
+	// Slice of structs
+	var users []taggedUser
+	err = WithDefaultLog(Lverbose).
+		WithDB(db).
+		From(u).
+		Where(u.USER_ID.LeInt(5)).
+		OrderBy(u.USER_ID).
+		SelectStruct(&users).
+		Fetch(nil)
+	is.NoErr(err)
+	is.Equal(5, len(users))
+	for i, user := range users {
+		is.Equal(i+1, user.UserID)
+		is.True(user.Displayname != "")
+	}
+
+	// Slice of struct pointers
+	var userPtrs []*taggedUser
+	err = WithDB(db).
+		From(u).
+		Where(u.USER_ID.LeInt(5)).
+		OrderBy(u.USER_ID).
+		SelectStruct(&userPtrs).
+		Fetch(nil)
+	is.NoErr(err)
+	is.Equal(5, len(userPtrs))
+	for i, user := range userPtrs {
+		is.Equal(users[i], *user)
+	}
+
+	// Single struct
+	var user taggedUser
+	err = WithDB(db).
+		From(u).
+		Where(u.USER_ID.EqInt(3)).
+		SelectStruct(&user).
+		Fetch(nil)
+	is.NoErr(err)
+	is.Equal(users[2], user)
+
+	// sql.ErrNoRows
+	err = WithDB(db).
+		From(u).
+		Where(u.USER_ID.EqInt(-1)).
+		SelectStruct(&user).
+		Fetch(nil)
+	is.True(errors.Is(err, sql.ErrNoRows))
+}
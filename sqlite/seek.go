@@ -0,0 +1,138 @@
+package sq
+
+import (
+	"database/sql/driver"
+
+	"github.com/bokwoon95/go-structured-query/internal/cursortoken"
+)
+
+// orderedField is a Field that carries its own ORDER BY direction and NULLS
+// ordering, as set by Asc, Desc, NullsFirst and NullsLast.
+type orderedField interface {
+	Field
+	// ordering returns the Field stripped of its ordering, together with the
+	// ordering that was stripped.
+	ordering() (field Field, descending, nullsfirst *bool)
+}
+
+// SeekAfter adds a predicate to the WHERE clause that only matches rows which
+// come after the cursor values in the current ORDER BY clause. The values are
+// matched to the ORDER BY fields in order, so OrderBy must be called before
+// SeekAfter. If there are fewer values than ORDER BY fields, only the leading
+// ORDER BY fields are used.
+//
+// The predicate follows the direction (Asc/Desc) and NULLS ordering
+// (NullsFirst/NullsLast) of each field. Fields without an explicit NULLS
+// ordering follow the SQLite default, which sorts NULLs as if they were
+// smaller than any other value. A nil cursor value is treated as NULL.
+func (q SelectQuery) SeekAfter(values ...interface{}) SelectQuery {
+	predicate := seekPredicate(q.OrderByFields, values, false)
+	if predicate != nil {
+		q.WherePredicate.Predicates = append(q.WherePredicate.Predicates, predicate)
+	}
+	return q
+}
+
+// SeekBefore adds a predicate to the WHERE clause that only matches rows which
+// come before the cursor values in the current ORDER BY clause. It is the
+// inverse of SeekAfter; the ORDER BY clause itself is left unchanged.
+func (q SelectQuery) SeekBefore(values ...interface{}) SelectQuery {
+	predicate := seekPredicate(q.OrderByFields, values, true)
+	if predicate != nil {
+		q.WherePredicate.Predicates = append(q.WherePredicate.Predicates, predicate)
+	}
+	return q
+}
+
+// seekPredicate builds the keyset predicate for the ORDER BY fields and
+// cursor values. For fields a, b and c it is equivalent to
+//
+//	a > ? OR (a = ? AND b > ?) OR (a = ? AND b = ? AND c > ?)
+//
+// where each comparison is flipped for descending fields and extended to
+// cover NULLs. It returns nil if there is nothing to seek on.
+func seekPredicate(orderByFields Fields, values []interface{}, before bool) Predicate {
+	n := len(orderByFields)
+	if len(values) < n {
+		n = len(values)
+	}
+	if n == 0 {
+		return nil
+	}
+	var equalities, disjuncts []Predicate
+	for i := 0; i < n; i++ {
+		var field Field = orderByFields[i]
+		var descending, nullsfirst *bool
+		if f, ok := field.(orderedField); ok {
+			field, descending, nullsfirst = f.ordering()
+		}
+		desc := descending != nil && *descending
+		// SQLite sorts NULLs as smaller than any other value by default
+		nullsFirst := !desc
+		if nullsfirst != nil {
+			nullsFirst = *nullsfirst
+		}
+		if before {
+			desc, nullsFirst = !desc, !nullsFirst
+		}
+		value := values[i]
+		if valuer, ok := value.(driver.Valuer); ok {
+			if v, err := valuer.Value(); err == nil && v == nil {
+				value = nil
+			}
+		}
+		var next Predicate
+		switch {
+		case value == nil && nullsFirst:
+			next = Predicatef("? IS NOT NULL", field)
+		case value == nil:
+			// nothing comes after a NULL when NULLs are sorted last
+		case desc && nullsFirst:
+			next = Predicatef("? < ?", field, value)
+		case desc:
+			next = Or(Predicatef("? < ?", field, value), Predicatef("? IS NULL", field))
+		case nullsFirst:
+			next = Predicatef("? > ?", field, value)
+		default:
+			next = Or(Predicatef("? > ?", field, value), Predicatef("? IS NULL", field))
+		}
+		if next != nil {
+			predicates := make([]Predicate, 0, len(equalities)+1)
+			predicates = append(predicates, equalities...)
+			predicates = append(predicates, next)
+			disjuncts = append(disjuncts, And(predicates...))
+		}
+		if value == nil {
+			equalities = append(equalities, Predicatef("? IS NULL", field))
+		} else {
+			equalities = append(equalities, Predicatef("? = ?", field, value))
+		}
+	}
+	if len(disjuncts) == 0 {
+		return Predicatef("FALSE")
+	}
+	return Or(disjuncts...)
+}
+
+// ErrInvalidCursor is returned by DecodeCursor if the cursor token is
+// malformed or has been tampered with.
+var ErrInvalidCursor = cursortoken.ErrInvalid
+
+// EncodeCursor encodes the cursor values into an opaque token that can be
+// handed out to clients, and later decoded with DecodeCursor to be passed to
+// SeekAfter or SeekBefore. The token is signed with the key using HMAC-SHA256
+// so that any tampering is detected, but it is not encrypted.
+//
+// Supported values are nil, bools, integers, floats, strings, []byte,
+// time.Time and any driver.Valuer that returns one of those.
+func EncodeCursor(key []byte, values ...interface{}) (string, error) {
+	return cursortoken.Encode(key, values...)
+}
+
+// DecodeCursor decodes a token created by EncodeCursor back into its cursor
+// values. Integers are decoded as int64 (or uint64), floats as float64 and
+// times as time.Time. It returns ErrInvalidCursor if the token was not signed
+// with the same key.
+func DecodeCursor(key []byte, token string) ([]interface{}, error) {
+	return cursortoken.Decode(key, token)
+}
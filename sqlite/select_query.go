@@ -0,0 +1,484 @@
+package sq
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+)
+
+// SelectType represents the various SQL selects.
+type SelectType string
+
+// SelectTypes
+const (
+	SelectTypeDefault  SelectType = "SELECT"
+	SelectTypeDistinct SelectType = "SELECT DISTINCT"
+)
+
+// SelectQuery represents a SELECT query.
+type SelectQuery struct {
+	nested bool
+	// WITH
+	CTEs []CTE
+	// SELECT
+	SelectType   SelectType
+	SelectFields Fields
+	// FROM
+	FromTable  Table
+	JoinTables JoinTables
+	// WHERE
+	WherePredicate VariadicPredicate
+	// GROUP BY
+	GroupByFields Fields
+	// HAVING
+	HavingPredicate VariadicPredicate
+	// WINDOW
+	Windows Windows
+	// ORDER BY
+	OrderByFields Fields
+	// LIMIT
+	LimitValue *int64
+	// OFFSET
+	OffsetValue *int64
+	// DB
+	DB          DB
+	RowMapper   func(*Row)
+	Accumulator func()
+	// Logging
+	Log     Logger
+	LogFlag LogFlag
+	LogFunc LogFunc
+	logSkip int
+}
+
+// ToSQL marshals the SelectQuery into a query string and args slice.
+func (q SelectQuery) ToSQL() (string, []interface{}) {
+	q.logSkip += 1
+	buf := &strings.Builder{}
+	var args []interface{}
+	q.AppendSQL(buf, &args, nil)
+	if q.LogFunc != nil {
+		q.LogFunc(LogInfo{
+			LogFlag: q.LogFlag,
+			LogSkip: q.logSkip + 2,
+			Query:   buf.String(),
+			Args:    args,
+			Action:  LogActionToSQL,
+		})
+	}
+	return buf.String(), args
+}
+
+// AppendSQL marshals the SelectQuery into a buffer and args slice.
+func (q SelectQuery) AppendSQL(buf *strings.Builder, args *[]interface{}, params map[string]int) {
+	// WITH
+	if !q.nested {
+		appendCTEs(buf, args, q.CTEs, q.FromTable, q.JoinTables)
+	}
+	// SELECT
+	if q.SelectType == "" {
+		q.SelectType = SelectTypeDefault
+	}
+	buf.WriteString(string(q.SelectType))
+	if len(q.SelectFields) > 0 {
+		buf.WriteString(" ")
+		q.SelectFields.AppendSQLExcludeWithAlias(buf, args, nil, nil)
+	}
+	// FROM
+	if q.FromTable != nil {
+		buf.WriteString(" FROM ")
+		switch v := q.FromTable.(type) {
+		case Query:
+			buf.WriteString("(")
+			v.NestThis().AppendSQL(buf, args, nil)
+			buf.WriteString(")")
+		default:
+			q.FromTable.AppendSQL(buf, args, nil)
+		}
+		alias := q.FromTable.GetAlias()
+		if alias != "" {
+			buf.WriteString(" AS ")
+			buf.WriteString(alias)
+		}
+	}
+	// JOIN
+	if len(q.JoinTables) > 0 {
+		buf.WriteString(" ")
+		q.JoinTables.AppendSQL(buf, args, nil)
+	}
+	// WHERE
+	if len(q.WherePredicate.Predicates) > 0 {
+		buf.WriteString(" WHERE ")
+		q.WherePredicate.toplevel = true
+		q.WherePredicate.AppendSQLExclude(buf, args, nil, nil)
+	}
+	// GROUP BY
+	if len(q.GroupByFields) > 0 {
+		buf.WriteString(" GROUP BY ")
+		q.GroupByFields.AppendSQLExclude(buf, args, nil, nil)
+	}
+	// HAVING
+	if len(q.HavingPredicate.Predicates) > 0 {
+		buf.WriteString(" HAVING ")
+		q.HavingPredicate.toplevel = true
+		q.HavingPredicate.AppendSQLExclude(buf, args, nil, nil)
+	}
+	// WINDOW
+	if len(q.Windows) > 0 {
+		buf.WriteString(" WINDOW ")
+		q.Windows.AppendSQL(buf, args, nil)
+	}
+	// ORDER BY
+	if len(q.OrderByFields) > 0 {
+		buf.WriteString(" ORDER BY ")
+		q.OrderByFields.AppendSQLExclude(buf, args, nil, nil)
+	}
+	// LIMIT
+	if q.LimitValue != nil {
+		buf.WriteString(" LIMIT ?")
+		if *q.LimitValue < 0 {
+			*q.LimitValue = -*q.LimitValue
+		}
+		*args = append(*args, *q.LimitValue)
+	}
+	// OFFSET
+	if q.OffsetValue != nil {
+		buf.WriteString(" OFFSET ?")
+		if *q.OffsetValue < 0 {
+			*q.OffsetValue = -*q.OffsetValue
+		}
+		*args = append(*args, *q.OffsetValue)
+	}
+	if !q.nested {
+		if q.Log != nil {
+			query := buf.String()
+			var logOutput string
+			switch {
+			case Lstats&q.LogFlag != 0:
+				logOutput = "\n----[ Executing query ]----\n" + query + " " + fmt.Sprint(*args) +
+					"\n----[ with bind values ]----\n" + questionInterpolate(query, *args...)
+			case Linterpolate&q.LogFlag != 0:
+				logOutput = questionInterpolate(query, *args...)
+			default:
+				logOutput = query + " " + fmt.Sprint(*args)
+			}
+			switch q.Log.(type) {
+			case *log.Logger:
+				_ = q.Log.Output(q.logSkip+2, logOutput)
+			default:
+				_ = q.Log.Output(q.logSkip+1, logOutput)
+			}
+		}
+	}
+}
+
+// From creates a new SelectQuery.
+func From(table Table) SelectQuery {
+	return SelectQuery{
+		FromTable: table,
+	}
+}
+
+// Fromx creates a new SelectQuery from the JoinedTables.
+func Fromx(joined JoinedTablesGetter) SelectQuery {
+	return From(nil).Fromx(joined)
+}
+
+// Select creates a new SelectQuery.
+func Select(fields ...Field) SelectQuery {
+	return SelectQuery{
+		SelectFields: fields,
+	}
+}
+
+// SelectOne creates a new SelectQuery.
+func SelectOne() SelectQuery {
+	return SelectQuery{
+		SelectFields: Fields{FieldLiteral("1")},
+	}
+}
+
+// SelectDistinct creates a new SelectQuery.
+func SelectDistinct(fields ...Field) SelectQuery {
+	return SelectQuery{
+		SelectType:   SelectTypeDistinct,
+		SelectFields: fields,
+	}
+}
+
+// Selectx creates a new SelectQuery.
+func Selectx(mapper func(*Row), accumulator func()) SelectQuery {
+	return SelectQuery{
+		RowMapper:   mapper,
+		Accumulator: accumulator,
+	}
+}
+
+// SelectRowx creates a new SelectQuery.
+func SelectRowx(mapper func(*Row)) SelectQuery {
+	return SelectQuery{
+		RowMapper: mapper,
+	}
+}
+
+// With appends a list of CTEs into the SelectQuery.
+func (q SelectQuery) With(ctes ...CTE) SelectQuery {
+	q.CTEs = append(q.CTEs, ctes...)
+	return q
+}
+
+// Select adds the fields to the SelectFields in the SelectQuery.
+func (q SelectQuery) Select(fields ...Field) SelectQuery {
+	q.SelectFields = append(q.SelectFields, fields...)
+	return q
+}
+
+// SelectOne sets the SELECT clause to SELECT 1.
+func (q SelectQuery) SelectOne() SelectQuery {
+	q.SelectFields = Fields{FieldLiteral("1")}
+	return q
+}
+
+// SelectAll sets the SELECT clause to SELECT *.
+func (q SelectQuery) SelectAll() SelectQuery {
+	q.SelectFields = Fields{FieldLiteral("*")}
+	return q
+}
+
+// SelectCount sets the SELECT clause to SELECT COUNT(*).
+func (q SelectQuery) SelectCount() SelectQuery {
+	q.SelectFields = Fields{FieldLiteral("COUNT(*)")}
+	return q
+}
+
+// SelectDistinct adds the fields to the SelectFields in the SelectQuery.
+func (q SelectQuery) SelectDistinct(fields ...Field) SelectQuery {
+	q.SelectType = SelectTypeDistinct
+	q.SelectFields = fields
+	return q
+}
+
+// From sets the table in the SelectQuery.
+func (q SelectQuery) From(table Table) SelectQuery {
+	q.FromTable = table
+	return q
+}
+
+// Fromx sets the FROM table of the SelectQuery to the JoinedTables' FROM
+// table, followed by its JoinTables.
+func (q SelectQuery) Fromx(joined JoinedTablesGetter) SelectQuery {
+	jt := joined.GetJoinedTables()
+	q.FromTable = jt.FromTable
+	q.JoinTables = jt.prependJoins(q.JoinTables)
+	return q
+}
+
+// Join joins a new table to the SelectQuery based on the predicates.
+func (q SelectQuery) Join(table Table, predicate Predicate, predicates ...Predicate) SelectQuery {
+	predicates = append([]Predicate{predicate}, predicates...)
+	q.JoinTables = append(q.JoinTables, JoinTable{
+		JoinType: JoinTypeInner,
+		Table:    table,
+		OnPredicates: VariadicPredicate{
+			Predicates: predicates,
+		},
+	})
+	return q
+}
+
+// LeftJoin left joins a new table to the SelectQuery based on the predicates.
+func (q SelectQuery) LeftJoin(table Table, predicate Predicate, predicates ...Predicate) SelectQuery {
+	predicates = append([]Predicate{predicate}, predicates...)
+	q.JoinTables = append(q.JoinTables, JoinTable{
+		JoinType: JoinTypeLeft,
+		Table:    table,
+		OnPredicates: VariadicPredicate{
+			Predicates: predicates,
+		},
+	})
+	return q
+}
+
+// RightJoin right joins a new table to the SelectQuery based on the predicates.
+func (q SelectQuery) RightJoin(table Table, predicate Predicate, predicates ...Predicate) SelectQuery {
+	predicates = append([]Predicate{predicate}, predicates...)
+	q.JoinTables = append(q.JoinTables, JoinTable{
+		JoinType: JoinTypeRight,
+		Table:    table,
+		OnPredicates: VariadicPredicate{
+			Predicates: predicates,
+		},
+	})
+	return q
+}
+
+// FullJoin full joins a table to the SelectQuery based on the predicates.
+func (q SelectQuery) FullJoin(table Table, predicate Predicate, predicates ...Predicate) SelectQuery {
+	predicates = append([]Predicate{predicate}, predicates...)
+	q.JoinTables = append(q.JoinTables, JoinTable{
+		JoinType: JoinTypeFull,
+		Table:    table,
+		OnPredicates: VariadicPredicate{
+			Predicates: predicates,
+		},
+	})
+	return q
+}
+
+// CustomJoin custom joins a table to the SelectQuery. The join type can be
+// specified with a string, e.g. "CROSS JOIN".
+func (q SelectQuery) CustomJoin(joinType JoinType, table Table, predicates ...Predicate) SelectQuery {
+	q.JoinTables = append(q.JoinTables, JoinTable{
+		JoinType: joinType,
+		Table:    table,
+		OnPredicates: VariadicPredicate{
+			Predicates: predicates,
+		},
+	})
+	return q
+}
+
+// Where appends the predicates to the WHERE clause in the SelectQuery.
+func (q SelectQuery) Where(predicates ...Predicate) SelectQuery {
+	q.WherePredicate.Predicates = append(q.WherePredicate.Predicates, predicates...)
+	return q
+}
+
+// GroupBy appends the fields to the GROUP BY clause in the SelectQuery.
+func (q SelectQuery) GroupBy(fields ...Field) SelectQuery {
+	q.GroupByFields = append(q.GroupByFields, fields...)
+	return q
+}
+
+// Having appends the predicates to the HAVING clause in the SelectQuery.
+func (q SelectQuery) Having(predicates ...Predicate) SelectQuery {
+	q.HavingPredicate.Predicates = append(q.HavingPredicate.Predicates, predicates...)
+	return q
+}
+
+// Window appends the windows to the WINDOW clause in the SelectQuery.
+func (q SelectQuery) Window(windows ...Window) SelectQuery {
+	q.Windows = append(q.Windows, windows...)
+	return q
+}
+
+// OrderBy appends the fields to the ORDER BY clause in the SelectQuery.
+func (q SelectQuery) OrderBy(fields ...Field) SelectQuery {
+	q.OrderByFields = append(q.OrderByFields, fields...)
+	return q
+}
+
+// Limit sets the limit in the SelectQuery.
+func (q SelectQuery) Limit(limit int) SelectQuery {
+	num := int64(limit)
+	q.LimitValue = &num
+	return q
+}
+
+// Offset sets the offset in the SelectQuery.
+func (q SelectQuery) Offset(offset int) SelectQuery {
+	num := int64(offset)
+	q.OffsetValue = &num
+	return q
+}
+
+// Selectx sets the mapper function and accumulator function in the SelectQuery.
+func (q SelectQuery) Selectx(mapper func(*Row), accumulator func()) SelectQuery {
+	q.RowMapper = mapper
+	q.Accumulator = accumulator
+	return q
+}
+
+// SelectRowx sets the mapper function in the SelectQuery.
+func (q SelectQuery) SelectRowx(mapper func(*Row)) SelectQuery {
+	q.RowMapper = mapper
+	return q
+}
+
+// Fetch will run SelectQuery with the given DB. It then maps the results based
+// on the mapper function (and optionally runs the accumulator function).
+func (q SelectQuery) Fetch(db DB) (err error) {
+	q.logSkip += 1
+	return q.FetchContext(nil, db)
+}
+
+// FetchContext will run SelectQuery with the given DB and context. It then
+// maps the results based on the mapper function (and optionally runs the
+// accumulator function).
+func (q SelectQuery) FetchContext(ctx context.Context, db DB) (err error) {
+	q.logSkip += 1
+	cq := q.compiledQuery()
+	q.Log = nil // the query is logged by the CompiledQuery
+	if fields, ok := mapperFields(q.RowMapper); ok {
+		q.SelectFields = fields
+		if len(q.SelectFields) == 0 {
+			q.SelectFields = Fields{FieldLiteral("1")}
+		}
+		buf := &strings.Builder{}
+		q.AppendSQL(buf, &cq.Args, nil)
+		cq.Query = buf.String()
+	}
+	return cq.fetchContext(ctx, db)
+}
+
+// Exec will execute the SelectQuery with the given DB. It will only compute
+// the rowsAffected if the ErowsAffected Execflag is passed to it.
+func (q SelectQuery) Exec(db DB, flag ExecFlag) (rowsAffected int64, err error) {
+	q.logSkip += 1
+	return q.ExecContext(nil, db, flag)
+}
+
+// ExecContext will execute the SelectQuery with the given DB and context. It will
+// only compute the rowsAffected if the ErowsAffected Execflag is passed to it.
+func (q SelectQuery) ExecContext(ctx context.Context, db DB, flag ExecFlag) (rowsAffected int64, err error) {
+	q.logSkip += 1
+	cq := q.compiledQuery()
+	q.Log = nil // the query is logged by the CompiledQuery
+	if len(q.SelectFields) == 0 {
+		q.SelectFields = Fields{FieldLiteral("1")}
+	}
+	buf := &strings.Builder{}
+	q.AppendSQL(buf, &cq.Args, nil)
+	cq.Query = buf.String()
+	return cq.execContext(ctx, db, flag, "Selected")
+}
+
+// compiledQuery returns a CompiledQuery that shares the SelectQuery's DB, mapper
+// and logging settings, so that the SelectQuery can be run by the same
+// fetchContext and execContext as a CompiledQuery once its query string and
+// args have been generated.
+func (q SelectQuery) compiledQuery() CompiledQuery {
+	return CompiledQuery{
+		DB:          q.DB,
+		RowMapper:   q.RowMapper,
+		Accumulator: q.Accumulator,
+		Log:         q.Log,
+		LogFlag:     q.LogFlag,
+		LogFunc:     q.LogFunc,
+		logSkip:     q.logSkip,
+	}
+}
+
+// mapperFields runs the mapper on an empty Row and returns the fields that it
+// scans. It reports false if there is no mapper or if the mapper panicked, in
+// which case fetchContext reports the error when it runs the mapper again.
+func mapperFields(mapper func(*Row)) (fields []Field, ok bool) {
+	if mapper == nil {
+		return nil, false
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+	r := &Row{}
+	mapper(r)
+	return r.fields, true
+}
+
+// NestThis indicates to the SelectQuery that it is nested.
+func (q SelectQuery) NestThis() Query {
+	q.nested = true
+	return q
+}
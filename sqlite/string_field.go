@@ -0,0 +1,544 @@
+package sq
+
+import (
+	"strconv"
+	"strings"
+)
+
+// EnumField is a type alias for StringField.
+type EnumField = StringField
+
+// NewEnumField returns an EnumField representing an enum column.
+func NewEnumField(name string, table Table) EnumField {
+	return NewStringField(name, table)
+}
+
+// StringField either represents a string column, a string expression or a
+// literal string value.
+type StringField struct {
+	// StringField will be one of the following:
+
+	// 1) String expression
+	// Examples of string expressions:
+	// | query              | args |
+	// |--------------------|------|
+	// | LOWER(users.email) |      |
+	// | SUBSTR(?, 1, 3)    | abcd |
+	format *string
+	values []interface{}
+
+	// 2) Literal string value
+	// Examples of literal string values:
+	// | query | args |
+	// |-------|------|
+	// | ?     | abcd |
+	value *string
+
+	// 3) String column
+	// Examples of string columns:
+	// | query       | args |
+	// |-------------|------|
+	// | users.name  |      |
+	// | name        |      |
+	// | users.email |      |
+	alias      string
+	table      Table
+	name       string
+	descending *bool
+	nullsfirst *bool
+}
+
+// AppendSQLExclude marshals the StringField into an SQL query and args as
+// described in the StringField internal struct comments.
+func (f StringField) AppendSQLExclude(buf *strings.Builder, args *[]interface{}, params map[string]int, excludedTableQualifiers []string) {
+	switch {
+	case f.format != nil:
+		// 1) String expression
+		expandValues(buf, args, excludedTableQualifiers, *f.format, f.values)
+	case f.value != nil:
+		// 2) Literal string value
+		buf.WriteString("?")
+		*args = append(*args, *f.value)
+	default:
+		// 3) String column
+		tableQualifier := f.table.GetAlias()
+		if tableQualifier == "" {
+			tableQualifier = f.table.GetName()
+		}
+		for _, excludedTableQualifier := range excludedTableQualifiers {
+			if tableQualifier == excludedTableQualifier {
+				tableQualifier = ""
+				break
+			}
+		}
+		if tableQualifier != "" {
+			if strings.ContainsAny(tableQualifier, " \t") {
+				buf.WriteString(`"`)
+				buf.WriteString(tableQualifier)
+				buf.WriteString(`".`)
+			} else {
+				buf.WriteString(tableQualifier)
+				buf.WriteString(".")
+			}
+		}
+		if strings.ContainsAny(f.name, " \t") {
+			buf.WriteString(`"`)
+			buf.WriteString(f.name)
+			buf.WriteString(`"`)
+		} else {
+			buf.WriteString(f.name)
+		}
+	}
+	if f.descending != nil {
+		if *f.descending {
+			buf.WriteString(" DESC")
+		} else {
+			buf.WriteString(" ASC")
+		}
+	}
+	if f.nullsfirst != nil {
+		if *f.nullsfirst {
+			buf.WriteString(" NULLS FIRST")
+		} else {
+			buf.WriteString(" NULLS LAST")
+		}
+	}
+}
+
+// NewStringField returns a new StringField representing a boolean column.
+func NewStringField(name string, table Table) StringField {
+	return StringField{
+		name:  name,
+		table: table,
+	}
+}
+
+// String returns a new StringField representing a literal string value.
+func String(s string) StringField {
+	return StringField{
+		value: &s,
+	}
+}
+
+// Set returns a FieldAssignment associating the StringField to the value i.e.
+// 'field = value'.
+func (f StringField) Set(value interface{}) FieldAssignment {
+	return FieldAssignment{
+		Field: f,
+		Value: value,
+	}
+}
+
+// SetString returns a FieldAssignment associating the StringField to the string
+// value i.e. 'field = value'.
+func (f StringField) SetString(s string) FieldAssignment {
+	return FieldAssignment{
+		Field: f,
+		Value: s,
+	}
+}
+
+// As returns a new StringField with the new field Alias i.e. 'field AS Alias'.
+func (f StringField) As(alias string) StringField {
+	f.alias = alias
+	return f
+}
+
+// Asc returns a new StringField indicating that it should be ordered in
+// ascending order i.e. 'ORDER BY field ASC'.
+func (f StringField) Asc() StringField {
+	desc := false
+	f.descending = &desc
+	return f
+}
+
+// Desc returns a new StringField indicating that it should be ordered in
+// descending order i.e. 'ORDER BY field DESC'.
+func (f StringField) Desc() StringField {
+	desc := true
+	f.descending = &desc
+	return f
+}
+
+// NullsFirst returns a new StringField indicating that it should be ordered
+// with nulls first i.e. 'ORDER BY field NULLS FIRST'.
+func (f StringField) NullsFirst() StringField {
+	nullsfirst := true
+	f.nullsfirst = &nullsfirst
+	return f
+}
+
+// NullsLast returns a new StringField indicating that it should be ordered
+// with nulls last i.e. 'ORDER BY field NULLS LAST'.
+func (f StringField) NullsLast() StringField {
+	nullsfirst := false
+	f.nullsfirst = &nullsfirst
+	return f
+}
+
+// ordering implements the orderedField interface.
+func (f StringField) ordering() (field Field, descending, nullsfirst *bool) {
+	descending, nullsfirst = f.descending, f.nullsfirst
+	f.descending, f.nullsfirst = nil, nil
+	return f, descending, nullsfirst
+}
+
+// IsNull returns an 'X IS NULL' Predicate.
+func (f StringField) IsNull() Predicate {
+	return CustomPredicate{
+		Format: "? IS NULL",
+		Values: []interface{}{f},
+	}
+}
+
+// IsNotNull returns an 'X IS NOT NULL' Predicate.
+func (f StringField) IsNotNull() Predicate {
+	return CustomPredicate{
+		Format: "? IS NOT NULL",
+		Values: []interface{}{f},
+	}
+}
+
+// Eq returns an 'X = Y' Predicate. It only accepts StringField.
+func (f StringField) Eq(field StringField) Predicate {
+	return CustomPredicate{
+		Format: "? = ?",
+		Values: []interface{}{f, field},
+	}
+}
+
+// Ne returns an 'X <> Y' Predicate. It only accepts StringField.
+func (f StringField) Ne(field StringField) Predicate {
+	return CustomPredicate{
+		Format: "? <> ?",
+		Values: []interface{}{f, field},
+	}
+}
+
+// Gt returns an 'X > Y' Predicate. It only accepts StringField.
+func (f StringField) Gt(field StringField) Predicate {
+	return CustomPredicate{
+		Format: "? > ?",
+		Values: []interface{}{f, field},
+	}
+}
+
+// Ge returns an 'X >= Y' Predicate. It only accepts StringField.
+func (f StringField) Ge(field StringField) Predicate {
+	return CustomPredicate{
+		Format: "? >= ?",
+		Values: []interface{}{f, field},
+	}
+}
+
+// Lt returns an 'X < Y' Predicate. It only accepts StringField.
+func (f StringField) Lt(field StringField) Predicate {
+	return CustomPredicate{
+		Format: "? < ?",
+		Values: []interface{}{f, field},
+	}
+}
+
+// Le returns an 'X <= Y' Predicate. It only accepts StringField.
+func (f StringField) Le(field StringField) Predicate {
+	return CustomPredicate{
+		Format: "? <= ?",
+		Values: []interface{}{f, field},
+	}
+}
+
+// EqString returns an 'X = Y' Predicate. It only accepts string.
+func (f StringField) EqString(s string) Predicate {
+	return CustomPredicate{
+		Format: "? = ?",
+		Values: []interface{}{f, s},
+	}
+}
+
+// NeString returns an 'X <> Y' Predicate. It only accepts string.
+func (f StringField) NeString(s string) Predicate {
+	return CustomPredicate{
+		Format: "? <> ?",
+		Values: []interface{}{f, s},
+	}
+}
+
+// GtString returns an 'X > Y' Predicate. It only accepts string.
+func (f StringField) GtString(s string) Predicate {
+	return CustomPredicate{
+		Format: "? > ?",
+		Values: []interface{}{f, s},
+	}
+}
+
+// GeString returns an 'X >= Y' Predicate. It only accepts string.
+func (f StringField) GeString(s string) Predicate {
+	return CustomPredicate{
+		Format: "? >= ?",
+		Values: []interface{}{f, s},
+	}
+}
+
+// LtString returns an 'X < Y' Predicate. It only accepts string.
+func (f StringField) LtString(s string) Predicate {
+	return CustomPredicate{
+		Format: "? < ?",
+		Values: []interface{}{f, s},
+	}
+}
+
+// LeString returns an 'X <= Y' Predicate. It only accepts string.
+func (f StringField) LeString(s string) Predicate {
+	return CustomPredicate{
+		Format: "? <= ?",
+		Values: []interface{}{f, s},
+	}
+}
+
+// LikeString returns an 'A LIKE B' Predicate. It only accepts string.
+func (f StringField) LikeString(s string) Predicate {
+	return CustomPredicate{
+		Format: "? LIKE ?",
+		Values: []interface{}{f, s},
+	}
+}
+
+// NotLikeString returns an 'A NOT LIKE B' Predicate. It only accepts string.
+func (f StringField) NotLikeString(s string) Predicate {
+	return CustomPredicate{
+		Format: "? NOT LIKE ?",
+		Values: []interface{}{f, s},
+	}
+}
+
+// In returns an 'X IN (Y)' Predicate.
+func (f StringField) In(v interface{}) Predicate {
+	var format string
+	var values []interface{}
+	switch v := v.(type) {
+	case RowValue:
+		format = "? IN ?"
+		values = []interface{}{f, v}
+	case Query:
+		format = "? IN (?)"
+		values = []interface{}{f, v.NestThis()}
+	default:
+		format = "? IN (?)"
+		values = []interface{}{f, v}
+	}
+	return CustomPredicate{
+		Format: format,
+		Values: values,
+	}
+}
+
+// Lower returns a new StringField converted to lowercase i.e. 'LOWER(field)'.
+func (f StringField) Lower() StringField {
+	format := "LOWER(?)"
+	return StringField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Upper returns a new StringField converted to uppercase i.e. 'UPPER(field)'.
+func (f StringField) Upper() StringField {
+	format := "UPPER(?)"
+	return StringField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Trim returns a new StringField with the leading and trailing spaces removed
+// i.e. 'TRIM(field)'.
+func (f StringField) Trim() StringField {
+	format := "TRIM(?)"
+	return StringField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Concat returns a new StringField that is the StringField followed by the
+// values i.e. 'field || value1 || value2'. The result is NULL if any of the
+// values are NULL.
+func (f StringField) Concat(values ...interface{}) StringField {
+	format := "?" + strings.Repeat(" || ?", len(values))
+	return StringField{
+		format: &format,
+		values: append([]interface{}{f}, values...),
+	}
+}
+
+// Substring returns a new StringField of length characters starting from the
+// start character, where the first character is 1 i.e. 'SUBSTR(field, start,
+// length)'.
+func (f StringField) Substring(start, length int) StringField {
+	format := "SUBSTR(?, " + strconv.Itoa(start) + ", " + strconv.Itoa(length) + ")"
+	return StringField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Length returns the number of characters in the StringField as a NumberField
+// i.e. 'LENGTH(field)'.
+func (f StringField) Length() NumberField {
+	format := "LENGTH(?)"
+	return NumberField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Replace returns a new StringField with every occurrence of from replaced by
+// to i.e. 'REPLACE(field, from, to)'.
+func (f StringField) Replace(from, to string) StringField {
+	format := "REPLACE(?, ?, ?)"
+	return StringField{
+		format: &format,
+		values: []interface{}{f, from, to},
+	}
+}
+
+// Left returns a new StringField of the first n characters i.e. 'SUBSTR(field,
+// 1, n)'. SQLite has no LEFT function.
+func (f StringField) Left(n int) StringField {
+	format := "SUBSTR(?, 1, " + strconv.Itoa(n) + ")"
+	return StringField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Right returns a new StringField of the last n characters i.e. 'SUBSTR(field,
+// MAX(LENGTH(field) - n + 1, 1))'. SQLite has no RIGHT function.
+func (f StringField) Right(n int) StringField {
+	format := "SUBSTR(?, MAX(LENGTH(?) - " + strconv.Itoa(n) + " + 1, 1))"
+	return StringField{
+		format: &format,
+		values: []interface{}{f, f},
+	}
+}
+
+// RegexpMatch returns an 'A REGEXP B' Predicate, which is true if the
+// StringField matches the regular expression. SQLite only supports REGEXP if
+// the application has registered a regexp() function.
+func (f StringField) RegexpMatch(pattern string) Predicate {
+	return CustomPredicate{
+		Format: "? REGEXP ?",
+		Values: []interface{}{f, pattern},
+	}
+}
+
+// StartsWith returns an 'A GLOB B' Predicate, which is true if the
+// StringField starts with the prefix. GLOB is used instead of LIKE because
+// LIKE is case insensitive in SQLite. Any '*', '?' or '[' in the prefix are
+// escaped so that they are matched literally.
+func (f StringField) StartsWith(prefix string) Predicate {
+	return CustomPredicate{
+		Format: "? GLOB ?",
+		Values: []interface{}{f, escapeGlob(prefix) + "*"},
+	}
+}
+
+// Collate returns a new StringField that uses the collation for comparing and
+// sorting e.g. 'field COLLATE NOCASE'.
+func (f StringField) Collate(name string) StringField {
+	operand := "?"
+	if f.format != nil {
+		operand = "(?)"
+	}
+	if !isIdentifier(name) {
+		name = `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+	}
+	format := operand + " COLLATE " + name
+	return StringField{
+		format: &format,
+		values: []interface{}{f},
+	}
+}
+
+// Coalesce returns a new StringField that is the first of the StringField and
+// the values that is not NULL i.e. 'COALESCE(field, value1, value2)'.
+func (f StringField) Coalesce(values ...interface{}) StringField {
+	format := "COALESCE(?" + strings.Repeat(", ?", len(values)) + ")"
+	return StringField{
+		format: &format,
+		values: append([]interface{}{f}, values...),
+	}
+}
+
+// NullIf returns a new StringField that is NULL if the StringField is equal to
+// the value, or else the StringField i.e. 'NULLIF(field, value)'.
+func (f StringField) NullIf(value interface{}) StringField {
+	format := "NULLIF(?, ?)"
+	return StringField{
+		format: &format,
+		values: []interface{}{f, value},
+	}
+}
+
+// escapeGlob escapes the GLOB wildcards in s by putting each of them inside a
+// character class.
+func escapeGlob(s string) string {
+	buf := &strings.Builder{}
+	for _, c := range s {
+		switch c {
+		case '*', '?', '[':
+			buf.WriteString("[")
+			buf.WriteRune(c)
+			buf.WriteString("]")
+		default:
+			buf.WriteRune(c)
+		}
+	}
+	return buf.String()
+}
+
+// isIdentifier reports whether s only consists of letters, digits and
+// underscores.
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if !(c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')) {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter returns a new StringField that only aggregates the rows which satisfy
+// the predicate i.e. 'field FILTER (WHERE predicate)'. The StringField must be
+// an aggregate function e.g. GroupConcat(field, separator).
+func (f StringField) Filter(predicate Predicate) StringField {
+	format := "? FILTER (WHERE ?)"
+	return StringField{
+		format: &format,
+		values: []interface{}{f, toplevelPredicate(predicate)},
+	}
+}
+
+// String implements the fmt.Stringer interface. It returns the string
+// representation of a StringField.
+func (f StringField) String() string {
+	buf := &strings.Builder{}
+	var args []interface{}
+	f.AppendSQLExclude(buf, &args, nil, nil)
+	return questionInterpolate(buf.String(), args...)
+}
+
+// GetAlias implements the Field interface. It returns the Alias of the
+// StringField.
+func (f StringField) GetAlias() string {
+	return f.alias
+}
+
+// GetName implements the Field interface. It returns the Name of the
+// StringField.
+func (f StringField) GetName() string {
+	return f.name
+}
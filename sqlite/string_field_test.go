@@ -0,0 +1,377 @@
+package sq
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestStringField_AppendSQLExclude(t *testing.T) {
+	type TT struct {
+		description string
+		f           StringField
+		exclude     []string
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	tests := []TT{
+		func() TT {
+			desc := "literal string"
+			f := String("lorem ipsum")
+			wantQuery := "?"
+			wantArgs := []interface{}{"lorem ipsum"}
+			return TT{desc, f, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "table qualified"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			wantQuery := "users.email"
+			return TT{desc, f, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "table alias qualified"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users", Alias: "u"})
+			wantQuery := "u.email"
+			return TT{desc, f, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "excludedTableQualifiers (name)"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			exclude := []string{"users"}
+			wantQuery := "email"
+			return TT{desc, f, exclude, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "excludedTableQualifiers (alias)"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users", Alias: "u"})
+			exclude := []string{"u"}
+			wantQuery := "email"
+			return TT{desc, f, exclude, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "quoted whitespace"
+			f := NewStringField("zip code", &TableInfo{Schema: "public", Name: "registered users"})
+			wantQuery := `"registered users"."zip code"`
+			return TT{desc, f, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "ASC"
+			f := NewStringField("zip code", &TableInfo{Schema: "public", Name: "registered users"}).Asc()
+			wantQuery := `"registered users"."zip code" ASC`
+			return TT{desc, f, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "DESC"
+			f := NewStringField("zip code", &TableInfo{Schema: "public", Name: "registered users"}).Desc()
+			wantQuery := `"registered users"."zip code" DESC`
+			return TT{desc, f, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "NULLS FIRST"
+			f := NewStringField("zip code", &TableInfo{Schema: "public", Name: "registered users"}).NullsFirst()
+			wantQuery := `"registered users"."zip code" NULLS FIRST`
+			return TT{desc, f, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "NULLS LAST"
+			f := NewStringField("zip code", &TableInfo{Schema: "public", Name: "registered users"}).NullsLast()
+			wantQuery := `"registered users"."zip code" NULLS LAST`
+			return TT{desc, f, nil, wantQuery, nil}
+		}(),
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			var _ Field = tt.f
+			tt.f.AppendSQLExclude(buf, &args, nil, tt.exclude)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
+
+func TestStringField_FieldAssignment(t *testing.T) {
+	type TT struct {
+		description string
+		a           FieldAssignment
+		exclude     []string
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+	tests := []TT{
+		{
+			"set field",
+			f.Set(f),
+			nil,
+			"users.email = users.email",
+			nil,
+		},
+		{
+			"set string",
+			f.Set("lorem ipsum"),
+			nil,
+			"users.email = ?",
+			[]interface{}{"lorem ipsum"},
+		},
+		{
+			"setstring string",
+			f.SetString("lorem ipsum"),
+			nil,
+			"users.email = ?",
+			[]interface{}{"lorem ipsum"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.a.AppendSQLExclude(buf, &args, nil, tt.exclude)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
+
+func TestStringField_Predicates(t *testing.T) {
+	type TT struct {
+		description string
+		p           Predicate
+		exclude     []string
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	tests := []TT{
+		func() TT {
+			desc := "IsNull"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			p := f.IsNull()
+			wantQuery := "users.email IS NULL"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "IsNotNull"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			p := f.IsNotNull()
+			wantQuery := "users.email IS NOT NULL"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "Eq"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			p := f.Eq(f)
+			wantQuery := "users.email = users.email"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "Ne"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			p := f.Ne(f)
+			wantQuery := "users.email <> users.email"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "Gt"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			p := f.Gt(f)
+			wantQuery := "users.email > users.email"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "Ge"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			p := f.Ge(f)
+			wantQuery := "users.email >= users.email"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "Lt"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			p := f.Lt(f)
+			wantQuery := "users.email < users.email"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "Le"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			p := f.Le(f)
+			wantQuery := "users.email <= users.email"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+		func() TT {
+			desc := "EqString"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			p := f.EqString("lorem ipsum")
+			wantQuery := "users.email = ?"
+			wantArgs := []interface{}{"lorem ipsum"}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "NeString"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			p := f.NeString("lorem ipsum")
+			wantQuery := "users.email <> ?"
+			wantArgs := []interface{}{"lorem ipsum"}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "GtString"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			p := f.GtString("lorem ipsum")
+			wantQuery := "users.email > ?"
+			wantArgs := []interface{}{"lorem ipsum"}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "GeString"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			p := f.GeString("lorem ipsum")
+			wantQuery := "users.email >= ?"
+			wantArgs := []interface{}{"lorem ipsum"}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "LtString"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			p := f.LtString("lorem ipsum")
+			wantQuery := "users.email < ?"
+			wantArgs := []interface{}{"lorem ipsum"}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "LeString"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			p := f.LeString("lorem ipsum")
+			wantQuery := "users.email <= ?"
+			wantArgs := []interface{}{"lorem ipsum"}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "LikeString"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			p := f.LikeString("lorem ipsum")
+			wantQuery := "users.email LIKE ?"
+			wantArgs := []interface{}{"lorem ipsum"}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "NotLikeString"
+			f := NewStringField("email", &TableInfo{Schema: "public", Name: "users"})
+			p := f.NotLikeString("lorem ipsum")
+			wantQuery := "users.email NOT LIKE ?"
+			wantArgs := []interface{}{"lorem ipsum"}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "In slice"
+			f := Fieldf("users.email")
+			p := f.In([]string{"a", "b", "c"})
+			wantQuery := "users.email IN (?, ?, ?)"
+			wantArgs := []interface{}{"a", "b", "c"}
+			return TT{desc, p, nil, wantQuery, wantArgs}
+		}(),
+		func() TT {
+			desc := "In Fields"
+			f := Fieldf("users.email")
+			p := f.In(Fields{f, f, f})
+			wantQuery := "users.email IN (users.email, users.email, users.email)"
+			return TT{desc, p, nil, wantQuery, nil}
+		}(),
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.p.AppendSQLExclude(buf, &args, nil, tt.exclude)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+}
+
+func TestStringField_Functions(t *testing.T) {
+	type TT struct {
+		description string
+		f           Field
+		wantQuery   string
+		wantArgs    []interface{}
+	}
+	tbl := &TableInfo{Name: "users"}
+	name := NewStringField("name", tbl)
+	email := NewStringField("email", tbl)
+	tests := []TT{
+		{"Lower", email.Lower(), "LOWER(users.email)", nil},
+		{"Upper Trim", name.Trim().Upper(), "UPPER(TRIM(users.name))", nil},
+		{"Concat", name.Concat(" <", email, ">"), "users.name || ? || users.email || ?", []interface{}{" <", ">"}},
+		{"Substring", name.Substring(2, 3), "SUBSTR(users.name, 2, 3)", nil},
+		{"Length", name.Length(), "LENGTH(users.name)", nil},
+		{"Replace", email.Replace("@", " at "), "REPLACE(users.email, ?, ?)", []interface{}{"@", " at "}},
+		{"Left", name.Left(1), "SUBSTR(users.name, 1, 1)", nil},
+		{"Right", name.Right(2), "SUBSTR(users.name, MAX(LENGTH(users.name) - 2 + 1, 1))", nil},
+		{"Collate", name.Collate("NOCASE").Asc(), "users.name COLLATE NOCASE ASC", nil},
+		{"Collate expression", name.Lower().Collate("my collation"), `(LOWER(users.name)) COLLATE "my collation"`, nil},
+		{"Coalesce", name.Coalesce(email, "anonymous"), "COALESCE(users.name, users.email, ?)", []interface{}{"anonymous"}},
+		{"NullIf", name.NullIf(""), "NULLIF(users.name, ?)", []interface{}{""}},
+		{"RegexpMatch", email.RegexpMatch(`^\w+@`), "users.email REGEXP ?", []interface{}{`^\w+@`}},
+		{"StartsWith", email.StartsWith("a*b?[c"), "users.email GLOB ?", []interface{}{"a[*]b[?][[]c*"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			is := is.New(t)
+			buf := &strings.Builder{}
+			var args []interface{}
+			tt.f.AppendSQLExclude(buf, &args, nil, nil)
+			is.Equal(tt.wantQuery, buf.String())
+			is.Equal(tt.wantArgs, args)
+		})
+	}
+
+	t.Run("Fetch", func(t *testing.T) {
+		if testing.Short() {
+			return
+		}
+		is := is.New(t)
+		db, err := sql.Open("devlab", "StringField_Functions")
+		is.NoErr(err)
+		defer db.Close()
+		s := String("  Hello, World  ")
+		var trimmed, left, right, substring, concat, coalesce string
+		var length int
+		var startsWith, startsWithWildcard bool
+		err = SelectRowx(func(row *Row) {
+			trimmed = row.String(s.Trim().Lower())
+			left = row.String(s.Trim().Left(5))
+			right = row.String(s.Trim().Right(5))
+			substring = row.String(s.Trim().Substring(8, 5))
+			concat = row.String(String("a").Concat("b", String("c")))
+			coalesce = row.String(String("").NullIf("").Coalesce("fallback"))
+			length = row.Int(s.Trim().Length())
+			startsWith = row.Bool(s.Trim().StartsWith("Hello"))
+			startsWithWildcard = row.Bool(s.Trim().StartsWith("H*"))
+		}).Fetch(db)
+		is.NoErr(err)
+		is.Equal("hello, world", trimmed)
+		is.Equal("Hello", left)
+		is.Equal("World", right)
+		is.Equal("World", substring)
+		is.Equal("abc", concat)
+		is.Equal("fallback", coalesce)
+		is.Equal(12, length)
+		is.True(startsWith)
+		is.True(!startsWithWildcard)
+	})
+}
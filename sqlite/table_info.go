@@ -0,0 +1,57 @@
+package sq
+
+import "strings"
+
+// TableInfo is struct that implements the Table interface, containing all the
+// information needed to call itself a Table. It is meant to be embedded in
+// arbitrary structs to also transform them into valid Tables.
+type TableInfo struct {
+	Schema string
+	Name   string
+	Alias  string
+}
+
+// AppendSQL adds the fully qualified table name into the buffer.
+func (tbl *TableInfo) AppendSQL(buf *strings.Builder, args *[]interface{}, params map[string]int) {
+	if tbl == nil {
+		return
+	}
+	if tbl.Schema != "" {
+		if strings.ContainsAny(tbl.Schema, " \t") {
+			buf.WriteString(`"`)
+			buf.WriteString(tbl.Schema)
+			buf.WriteString(`".`)
+		} else {
+			buf.WriteString(tbl.Schema)
+			buf.WriteString(".")
+		}
+	}
+	if strings.ContainsAny(tbl.Name, " \t") {
+		buf.WriteString(`"`)
+		buf.WriteString(tbl.Name)
+		buf.WriteString(`"`)
+	} else {
+		buf.WriteString(tbl.Name)
+	}
+}
+
+// GetAlias implements the Table interface. It returns the alias from the
+// TableInfo.
+func (tbl *TableInfo) GetAlias() string {
+	if tbl == nil {
+		return ""
+	}
+	return tbl.Alias
+}
+
+// GetName implements the Table interface. It returns the name from the
+// TableInfo.
+func (tbl *TableInfo) GetName() string {
+	if tbl == nil {
+		return ""
+	}
+	return tbl.Name
+}
+
+// AssertBaseTable implements the BaseTable interface.
+func (tbl *TableInfo) AssertBaseTable() {}
@@ -0,0 +1,141 @@
+package sq
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+)
+
+// TxOptions holds the transaction options used by Tx.
+type TxOptions struct {
+	Isolation sql.IsolationLevel
+	ReadOnly  bool
+	// MaxRetries is the number of times the transaction will be retried if it
+	// fails because the database is busy or locked (SQLITE_BUSY or
+	// SQLITE_LOCKED). The function passed to Tx must be safe to run more than
+	// once if MaxRetries is non-zero. Only the outermost Tx is retried: nested
+	// calls return the error to the outermost Tx.
+	MaxRetries int
+}
+
+// txBeginner is a DB that can start a transaction, e.g. *sql.DB.
+type txBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// txn is a transaction started by Tx.
+type txn struct {
+	db       DB
+	commit   func() error
+	rollback func() error
+}
+
+var savepointCount uint64
+
+// Tx runs fn inside a transaction, passing in a DB that runs queries in that
+// transaction. If fn returns nil the transaction is committed, otherwise it is
+// rolled back and the error is returned. If fn panics the transaction is
+// rolled back before the panic continues.
+//
+// If db is already a transaction (a *sql.Tx, such as the DB passed to fn by an
+// enclosing Tx) then a SAVEPOINT is used instead: it is released if fn returns
+// nil, and rolled back to otherwise. The opts are ignored for nested calls.
+// Any other DB that cannot begin a transaction is an error, since a SAVEPOINT
+// outside of a transaction does not make fn atomic.
+func Tx(ctx context.Context, db DB, opts *TxOptions, fn func(tx DB) error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opts == nil {
+		opts = &TxOptions{}
+	}
+	var begin func() (txn, error)
+	switch v := db.(type) {
+	case txBeginner:
+		begin = func() (txn, error) {
+			tx, err := v.BeginTx(ctx, &sql.TxOptions{Isolation: opts.Isolation, ReadOnly: opts.ReadOnly})
+			if err != nil {
+				return txn{}, err
+			}
+			return txn{db: tx, commit: tx.Commit, rollback: tx.Rollback}, nil
+		}
+	case *sql.Tx:
+		return savepoint(ctx, v, fn)
+	default:
+		return fmt.Errorf("%T can neither begin a transaction nor is it a transaction", db)
+	}
+	for attempt := 0; ; attempt++ {
+		err := runTx(begin, fn)
+		if err == nil || attempt >= opts.MaxRetries || !isRetryableTxError(err) {
+			return err
+		}
+	}
+}
+
+// runTx runs fn inside a new transaction, committing it if fn returns nil and
+// rolling it back otherwise.
+func runTx(begin func() (txn, error), fn func(tx DB) error) (err error) {
+	tx, err := begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			_ = tx.rollback()
+			panic(r)
+		}
+	}()
+	err = fn(tx.db)
+	if err != nil {
+		_ = tx.rollback()
+		return err
+	}
+	return tx.commit()
+}
+
+// savepoint runs fn inside a new SAVEPOINT, releasing it if fn returns nil and
+// rolling back to it otherwise.
+func savepoint(ctx context.Context, db DB, fn func(tx DB) error) (err error) {
+	name := "sq_savepoint_" + strconv.FormatUint(atomic.AddUint64(&savepointCount, 1), 10)
+	_, err = db.ExecContext(ctx, "SAVEPOINT "+name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			_, _ = db.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+			panic(r)
+		}
+	}()
+	err = fn(db)
+	if err != nil {
+		_, rollbackErr := db.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+		if rollbackErr != nil {
+			return errors.Join(err, rollbackErr)
+		}
+		return err
+	}
+	_, err = db.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	return err
+}
+
+// sqliteErrorCoder is implemented by the errors of SQLite drivers that expose
+// the SQLite result code, e.g. *sqlite.Error from modernc.org/sqlite.
+type sqliteErrorCoder interface {
+	Code() int
+}
+
+// isRetryableTxError reports whether the error is SQLITE_BUSY (5) or
+// SQLITE_LOCKED (6), in which case the transaction can be retried. Extended
+// result codes are reduced to their primary result code.
+func isRetryableTxError(err error) bool {
+	var coder sqliteErrorCoder
+	if !errors.As(err, &coder) {
+		return false
+	}
+	code := coder.Code() & 0xff
+	return code == 5 || code == 6
+}